@@ -0,0 +1,35 @@
+package emission
+
+// OnAny adds the listener for every event emitted, as listeners of Any are,
+// receiving the event as its first argument, and returns the Handle
+// identifying it. Unlike wildcard patterns, Any matches events of every
+// type. 0 is returned if the listener could not be added.
+func (emitter *Emitter) OnAny(listener interface{}) Handle {
+	return emitter.OnAnyWhen(nil, listener)
+}
+
+// OnAnyWhen adds the listener as OnAny does, calling it only for the events
+// accepted by the predicate, if non-nil.
+func (emitter *Emitter) OnAnyWhen(predicate func(event interface{}) bool, listener interface{}) Handle {
+	record := emitter.addListener(Any, listener, &listenerRecord{predicate: predicate}, false)
+
+	if nil == record {
+		return 0
+	}
+
+	return record.handle
+}
+
+// OffAny removes the listener added by OnAny or OnAnyWhen identified by the
+// handle.
+func (emitter *Emitter) OffAny(handle Handle) *Emitter {
+	emitter.RLock()
+	record, ok := emitter.handles[handle]
+	emitter.RUnlock()
+
+	if ok && Any == record.event {
+		emitter.removeRecord(record)
+	}
+
+	return emitter
+}
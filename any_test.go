@@ -0,0 +1,47 @@
+package emission
+
+import (
+	"testing"
+)
+
+type anyEvent struct {
+	id int
+}
+
+func TestOnAny(t *testing.T) {
+	received := []interface{}{}
+	filtered := []interface{}{}
+
+	emitter := NewEmitter()
+
+	handle := emitter.OnAny(func(event interface{}, arguments ...interface{}) {
+		received = append(received, event)
+	})
+
+	emitter.OnAnyWhen(func(event interface{}) bool {
+		_, ok := event.(anyEvent)
+		return ok
+	}, func(event interface{}, arguments ...interface{}) {
+		filtered = append(filtered, event)
+	})
+
+	emitter.EmitSync(1).EmitSync(anyEvent{2}, "a").EmitSync("three")
+
+	if 3 != len(received) || 1 != received[0] || (anyEvent{2}) != received[1] || "three" != received[2] {
+		t.Error("Failed to call the listener for every event.", received)
+	}
+
+	if 1 != len(filtered) || (anyEvent{2}) != filtered[0] {
+		t.Error("Failed to call the listener only for the events accepted.", filtered)
+	}
+
+	emitter.OffAny(handle).EmitSync(4)
+
+	if 3 != len(received) {
+		t.Error("Failed to remove the listener identified by the handle.", received)
+	}
+
+	if 0 != NewEmitter().RecoverWith(func(event, listener interface{}, err error) {}).OnAny(nil) {
+		t.Error("Returned a handle for a listener which could not be added.")
+	}
+}
@@ -0,0 +1,173 @@
+package emission
+
+import (
+	"reflect"
+)
+
+// Type of interface{} used to marshal nil arguments without a parameter.
+var interfaceType = reflect.TypeOf((*interface{})(nil)).Elem()
+
+// marshaling determines how arguments are marshaled into the parameters of
+// a listener.
+type marshaling struct {
+	// Whether extra arguments are dropped and missing arguments are
+	// replaced by zero values.
+	tolerant bool
+	// Whether arguments must be assignable to their parameters, rather
+	// than being converted to the parameters' types when convertible.
+	strict bool
+}
+
+// SetTolerantArity enables or disables tolerant arity, disabled by default.
+// While enabled, a listener declaring fewer parameters than the arguments
+// emitted is called with the extra arguments dropped, and a listener
+// declaring more is called with the missing arguments replaced by the zero
+// values of their parameters, instead of panicking.
+func (emitter *Emitter) SetTolerantArity(enabled bool) *Emitter {
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	emitter.marshaling.tolerant = enabled
+	emitter.publish()
+	return emitter
+}
+
+// SetStrictTypes enables or disables strict types, disabled by default.
+// Unless enabled, an argument which is not assignable to its parameter but
+// is convertible to the parameter's type, such as an int to an int64 or a
+// named string type to a string, is converted instead of panicking. Numbers
+// are never converted to strings.
+func (emitter *Emitter) SetStrictTypes(enabled bool) *Emitter {
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	emitter.marshaling.strict = enabled
+	emitter.publish()
+	return emitter
+}
+
+// marshal appends the values to call the listener function with for the
+// arguments to values, returning the result. A nil argument is replaced by
+// the zero value of the matching parameter, which for a variadic parameter
+// is the zero value of its elements, such as a nil interface. Arguments are
+// dropped, replaced or converted as determined by the marshaling.
+func (l *listenerRecord) marshal(values []reflect.Value, arguments []interface{}, m marshaling) []reflect.Value {
+	fixed := l.fixed
+
+	if m.tolerant && nil == l.variadic && len(arguments) > fixed {
+		arguments = arguments[:fixed]
+	}
+
+	for i, argument := range arguments {
+		param := l.param(i)
+
+		if nil == argument {
+			if nil == param {
+				// Left for the reflect package to refuse the
+				// extra argument.
+				param = interfaceType
+			}
+
+			values = append(values, reflect.Zero(param))
+			continue
+		}
+
+		value := reflect.ValueOf(argument)
+
+		if nil != param && !m.strict && convertible(value.Type(), param) {
+			value = value.Convert(param)
+		}
+
+		values = append(values, value)
+	}
+
+	for i := len(arguments); m.tolerant && i < fixed; i++ {
+		values = append(values, reflect.Zero(l.params[i]))
+	}
+
+	return values
+}
+
+// accepts reports whether the listener can be called with the arguments,
+// preceded by the event if the listener was added for it and by a context
+// if the listener accepts one, as contextual prepends them, as determined
+// by the marshaling.
+func (l *listenerRecord) accepts(event interface{}, arguments []interface{}, m marshaling) bool {
+	if nil != l.direct || nil != l.envelope || nil != l.batch {
+		return true
+	}
+
+	return assignable(l.fn.Type(), l.contextual(nil, event, arguments), m)
+}
+
+// assignable reports whether the function type can be called with the
+// arguments, as determined by the marshaling.
+func assignable(t reflect.Type, arguments []interface{}, m marshaling) bool {
+	n := t.NumIn()
+
+	if !m.tolerant && (t.IsVariadic() && len(arguments) < n-1 || !t.IsVariadic() && len(arguments) != n) {
+		return false
+	}
+
+	for i, argument := range arguments {
+		param := paramAt(t, i)
+
+		if nil == param {
+			break
+		}
+
+		if nil == argument {
+			continue
+		}
+
+		if at := reflect.TypeOf(argument); !at.AssignableTo(param) && (m.strict || !convertible(at, param)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// param returns the type of the listener function's parameter the i-th
+// argument is passed to, as paramAt does, from the types cached by setFunc.
+func (l *listenerRecord) param(i int) reflect.Type {
+	if i < l.fixed {
+		return l.params[i]
+	}
+
+	return l.variadic
+}
+
+// paramAt returns the type of the function type's parameter receiving the
+// i-th argument, or nil if there is none.
+func paramAt(t reflect.Type, i int) reflect.Type {
+	n := t.NumIn()
+
+	switch {
+	case t.IsVariadic() && i >= n-1:
+		return t.In(n - 1).Elem()
+	case i < n:
+		return t.In(i)
+	}
+
+	return nil
+}
+
+// convertible reports whether a value of the type is converted to the
+// parameter's type rather than passed as is. Numbers are not converted to
+// strings, nor slices to arrays, whose conversions may not be meaningful or
+// may panic.
+func convertible(from, param reflect.Type) bool {
+	if from.AssignableTo(param) || !from.ConvertibleTo(param) {
+		return false
+	}
+
+	switch {
+	case reflect.String == param.Kind() && reflect.String != from.Kind():
+		return false
+	case reflect.Slice == from.Kind() && reflect.Slice != param.Kind():
+		return false
+	}
+
+	return true
+}
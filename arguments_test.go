@@ -0,0 +1,103 @@
+package emission
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestSetTolerantArity(t *testing.T) {
+	event := "test"
+
+	var (
+		mutex  sync.Mutex
+		a, b   int
+		called int
+	)
+
+	// TryEmit calls the listeners concurrently.
+	emitter := NewEmitter().
+		SetTolerantArity(true).
+		On(event, func(n int) { mutex.Lock(); a = n; called++; mutex.Unlock() }).
+		On(event, func(n, m int) { mutex.Lock(); b = m; called++; mutex.Unlock() })
+
+	emitter.EmitSync(event, 1, 2, 3)
+
+	if 2 != called || 1 != a || 2 != b {
+		t.Error("Failed to drop the extra arguments.", called, a, b)
+	}
+
+	emitter.EmitSync(event, 4)
+
+	if 4 != called || 4 != a || 0 != b {
+		t.Error("Failed to pad the missing arguments.", called, a, b)
+	}
+
+	if err := emitter.TryEmit(event); nil != err {
+		t.Error("Failed to accept too few arguments.", err)
+	}
+
+	if err := emitter.TryEmit(event, "a"); ErrArgumentMismatch != err {
+		t.Error("Failed to refuse mismatching arguments.", err)
+	}
+}
+
+type namedString string
+
+func TestSetStrictTypes(t *testing.T) {
+	event := "test"
+
+	var (
+		n int64
+		s string
+	)
+
+	emitter := NewEmitter().On(event, func(i int64, str string) { n, s = i, str })
+
+	if err := emitter.TryEmit(event, 1, namedString("a")); nil != err || 1 != n || "a" != s {
+		t.Error("Failed to convert the arguments.", err, n, s)
+	}
+
+	if err := emitter.TryEmit(event, 1, 2); ErrArgumentMismatch != err {
+		t.Error("Converted a number to a string.", err)
+	}
+
+	if err := emitter.SetStrictTypes(true).TryEmit(event, 1, "a"); ErrArgumentMismatch != err {
+		t.Error("Converted an argument with strict types.", err)
+	}
+}
+
+func TestEmitNilVariadicArgument(t *testing.T) {
+	event := "test"
+
+	var (
+		err    error
+		values []interface{}
+		errs   []error
+	)
+
+	NewEmitter().
+		On(event, func(e error, rest ...interface{}) { err, values = e, rest }).
+		On(event, func(e error, more ...error) { errs = more }).
+		EmitSync(event, nil, nil, nil)
+
+	if nil != err || 2 != len(values) || nil != values[0] || nil != values[1] {
+		t.Error("Failed to pass nil interfaces for nil variadic arguments.", err, values)
+	}
+
+	if 2 != len(errs) || nil != errs[0] || nil != errs[1] {
+		t.Error("Failed to pass nil errors for nil variadic arguments.", errs)
+	}
+}
+
+func TestEmitNilExtraArgument(t *testing.T) {
+	var recovered error
+
+	NewEmitter().
+		RecoverWith(func(event, listener interface{}, err error) { recovered = err }).
+		On("test", func(n int) {}).
+		EmitSync("test", 1, nil)
+
+	if nil == recovered {
+		t.Error("Failed to refuse an extra nil argument.")
+	}
+}
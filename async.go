@@ -0,0 +1,199 @@
+package emission
+
+import (
+	"context"
+	"fmt"
+	"runtime/pprof"
+	"sync"
+)
+
+// EmitAsync calls each listener of the event within its own go routine as
+// Emit does, but returns immediately instead of waiting for the listeners
+// to return.
+func (emitter *Emitter) EmitAsync(event interface{}, arguments ...interface{}) *Emitter {
+	emitter.emit(nil, Event{Name: event, Args: arguments}, emitter.async)
+	return emitter
+}
+
+// async calls each listener within its own go routine without waiting
+// for them to return.
+func (emitter *Emitter) async(ctx context.Context, event interface{}, listeners []*listenerRecord, arguments []interface{}) {
+	for _, l := range listeners {
+		l := l
+
+		emitter.spawn(event, l, func() {
+			emitter.call(ctx, event, l, arguments)
+		})
+	}
+}
+
+// EmitFuture calls each listener of the event within its own go routine as
+// EmitAsync does, returning a channel which is closed once the listeners
+// have all returned. If the emission is deferred, such as while the Emitter
+// is paused, the channel is closed once the listeners it is delivered to
+// have returned, or once it is dropped. The channel is closed immediately
+// if there are no listeners to call.
+func (emitter *Emitter) EmitFuture(event interface{}, arguments ...interface{}) <-chan struct{} {
+	var (
+		once       sync.Once
+		dispatched bool
+	)
+
+	done := make(chan struct{})
+	complete := func() { once.Do(func() { close(done) }) }
+
+	_, deferred := emitter.emit(nil, Event{Name: event, Args: arguments, discarded: complete}, func(ctx context.Context, event interface{}, listeners []*listenerRecord, arguments []interface{}) {
+		var wg sync.WaitGroup
+
+		dispatched = true
+		wg.Add(len(listeners))
+
+		for _, l := range listeners {
+			l := l
+
+			emitter.spawn(event, l, func() {
+				defer wg.Done()
+
+				emitter.call(ctx, event, l, arguments)
+			})
+		}
+
+		go func() {
+			wg.Wait()
+			complete()
+		}()
+	})
+
+	if !deferred && !dispatched {
+		complete()
+	}
+
+	return done
+}
+
+// Wait blocks until every listener called within its own go routine by the
+// Emitter has returned. Such listeners, as called by Emit, are themselves
+// counted, so Wait must not be called by one: it would wait for the calling
+// listener to return and never return itself.
+func (emitter *Emitter) Wait() {
+	emitter.WaitContext(context.Background())
+}
+
+// WaitContext blocks until every listener called within its own go routine
+// by the Emitter has returned, or until ctx is done, in which case the
+// context's error is returned. As with Wait, a listener running within its
+// own go routine waits for itself, so WaitContext only returns once ctx is
+// done when called by one.
+func (emitter *Emitter) WaitContext(ctx context.Context) error {
+	for {
+		if 0 == emitter.inflight.Load() {
+			return nil
+		}
+
+		idle := emitter.idleSignal()
+
+		// The last job may have finished before the channel was made.
+		if 0 == emitter.inflight.Load() {
+			return nil
+		}
+
+		select {
+		case <-idle:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// spawn calls fn, a call of the listener for the event, using the Emitter's
+// Dispatcher, counting it as in flight until it returns. When the Emitter's
+// event loop is enabled, fn is called immediately instead so that listeners
+// never run concurrently, and when the listener was added with WithFIFO or
+// mailboxes are enabled fn is queued in the listener's queue or mailbox.
+// When profiler labels are enabled, fn is called with labels identifying the
+// event and listener.
+func (emitter *Emitter) spawn(event interface{}, l *listenerRecord, fn func()) {
+	emitter.begin()
+
+	t := emitter.load()
+	dispatcher, loop, labeled := t.dispatcher, t.loop, t.labeled
+
+	job := func() {
+		defer emitter.end()
+
+		if labeled {
+			pprof.Do(context.Background(), pprof.Labels(
+				"event", fmt.Sprint(event),
+				"listener", l.displayName(),
+			), func(context.Context) {
+				fn()
+			})
+
+			return
+		}
+
+		fn()
+	}
+
+	if nil != loop {
+		job()
+		return
+	}
+
+	if nil != l.fifo {
+		if !l.fifo.post(job) {
+			// The listener has been removed.
+			emitter.end()
+		}
+
+		return
+	}
+
+	if m := emitter.mailboxFor(l); nil != m {
+		if !m.post(job) {
+			// The listener has been removed.
+			emitter.end()
+		}
+
+		return
+	}
+
+	if nil == dispatcher {
+		dispatcher = GoroutineDispatcher{}
+	}
+
+	dispatcher.Dispatch(job)
+}
+
+// begin counts a job as in flight.
+func (emitter *Emitter) begin() {
+	emitter.inflight.Add(1)
+}
+
+// end counts a job in flight as finished, waking any waiters once no jobs
+// are in flight.
+func (emitter *Emitter) end() {
+	if 0 != emitter.inflight.Add(-1) {
+		return
+	}
+
+	if idle := emitter.idle.Swap(nil); nil != idle {
+		close(*idle)
+	}
+}
+
+// idleSignal returns the channel closed once no jobs are in flight, making
+// it if no other waiter has.
+func (emitter *Emitter) idleSignal() <-chan struct{} {
+	for {
+		if idle := emitter.idle.Load(); nil != idle {
+			return *idle
+		}
+
+		idle := make(chan struct{})
+
+		if emitter.idle.CompareAndSwap(nil, &idle) {
+			return idle
+		}
+	}
+}
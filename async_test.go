@@ -0,0 +1,155 @@
+package emission
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestEmitAsync(t *testing.T) {
+	event := "test"
+	release := make(chan struct{})
+	done := make(chan struct{})
+
+	NewEmitter().
+		AddListener(event, func() {
+			<-release
+			close(done)
+		}).
+		EmitAsync(event)
+
+	// The listener is still blocked, so EmitAsync must have returned
+	// without waiting for it.
+	close(release)
+	<-done
+}
+
+func TestEmitFuture(t *testing.T) {
+	event := "test"
+	release := make(chan struct{})
+	flag := false
+
+	done := NewEmitter().
+		AddListener(event, func() {
+			<-release
+			flag = true
+		}).
+		EmitFuture(event)
+
+	select {
+	case <-done:
+		t.Error("EmitFuture completed before the listener returned.")
+	default:
+	}
+
+	close(release)
+	<-done
+
+	if !flag {
+		t.Error("EmitFuture completed without calling the listener.")
+	}
+
+	<-NewEmitter().EmitFuture(event)
+}
+
+func TestEmitFutureDeferred(t *testing.T) {
+	var called atomic.Bool
+
+	emitter := NewEmitter().
+		On("test", func() { called.Store(true) }).
+		Pause()
+
+	done := emitter.EmitFuture("test")
+
+	select {
+	case <-done:
+		t.Error("EmitFuture completed while the emission was buffered.")
+	default:
+	}
+
+	if err := emitter.TryEmit("test"); nil != err {
+		t.Error("Reported the buffered emission as having no listeners.", err)
+	}
+
+	if 1 != emitter.EmitCount("test") {
+		t.Error("Failed to count the listeners of the buffered emission.")
+	}
+
+	emitter.Resume()
+	<-done
+
+	if !called.Load() {
+		t.Error("EmitFuture completed without calling the listener.")
+	}
+
+	emitter.SetEventLoop(true)
+	called.Store(false)
+	<-emitter.EmitFuture("test")
+
+	if !called.Load() {
+		t.Error("EmitFuture completed before the queued emission was delivered.")
+	}
+
+	// A future of an emission dropped when the Emitter is closed completes.
+	dropped := NewEmitter().On("test", func() {}).Pause()
+	done = dropped.EmitFuture("test")
+	dropped.Close()
+	<-done
+}
+
+func TestWait(t *testing.T) {
+	event := "test"
+	invoked := make(chan struct{}, 2)
+
+	emitter := NewEmitter().
+		AddListener(event, func() {
+			time.Sleep(10 * time.Millisecond)
+			invoked <- struct{}{}
+		}).
+		EmitAsync(event).
+		EmitAsync(event)
+
+	emitter.Wait()
+
+	if 2 != len(invoked) {
+		t.Error("Wait returned before the listeners returned.")
+	}
+}
+
+func TestWaitContext(t *testing.T) {
+	event := "test"
+	release := make(chan struct{})
+	defer close(release)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := NewEmitter().
+		AddListener(event, func() { <-release }).
+		EmitAsync(event).
+		WaitContext(ctx)
+
+	if context.DeadlineExceeded != err {
+		t.Error("WaitContext failed to return the context's error.", err)
+	}
+}
+
+func TestWaitContextFromListener(t *testing.T) {
+	event := "test"
+	var err error
+
+	emitter := NewEmitter()
+	emitter.AddListener(event, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		err = emitter.WaitContext(ctx)
+	})
+
+	emitter.Emit(event)
+
+	if context.DeadlineExceeded != err {
+		t.Error("WaitContext failed to return the context's error when called by a listener.", err)
+	}
+}
@@ -0,0 +1,107 @@
+package emission
+
+import (
+	"context"
+	"sync"
+)
+
+// batchKey is the context key the batch of Events delivered to batch
+// listeners by EmitBatch is stored under.
+type batchKey struct{}
+
+// EmitBatch emits each Event in order, calling each listener synchronously
+// as EmitEvent does, except listeners of type func([]Event). These are
+// called once the batch has been emitted, each with the Events of the batch
+// it was dispatched, in order. Outside of EmitBatch, they are called with
+// the envelope of each emission alone. The Emitter's listeners and settings
+// are read once for the whole batch, and each event's listeners resolved
+// once however many of its Events the batch holds, so listeners added or
+// removed by a listener take effect after the batch. If the Emitter is
+// paused or closed, has an event loop or has debounced, throttled or
+// coalesced events, each Event is instead emitted in turn as EmitEvent
+// does. Events of the batch buffered while the Emitter is paused or queued
+// by its event loop are delivered to batch listeners alone once dispatched.
+func (emitter *Emitter) EmitBatch(events []Event) *Emitter {
+	var (
+		mutex   sync.Mutex
+		flushed bool
+		order   []*listenerRecord
+	)
+
+	batches := make(map[*listenerRecord][]Event)
+
+	dispatch := func(ctx context.Context, event interface{}, listeners []*listenerRecord, arguments []interface{}) {
+		for _, l := range listeners {
+			if nil != ctx.Err() {
+				break
+			}
+
+			if nil != l.batch {
+				mutex.Lock()
+
+				if !flushed {
+					if _, ok := batches[l]; !ok {
+						order = append(order, l)
+					}
+
+					batches[l] = append(batches[l], envelope(ctx, event, arguments))
+					mutex.Unlock()
+					continue
+				}
+
+				mutex.Unlock()
+			}
+
+			emitter.call(ctx, event, l, arguments)
+		}
+	}
+
+	t := emitter.load()
+	direct := !t.closed && !t.paused && nil == t.loop && !t.debouncing && !t.throttling && !t.coalescing
+	resolved := make(map[interface{}][]*listenerRecord)
+
+	for _, e := range events {
+		if !direct {
+			emitter.emit(nil, e, dispatch)
+			continue
+		}
+
+		ctx, ok := emitter.admit(nil, e)
+
+		if !ok {
+			continue
+		}
+
+		listeners, ok := resolved[e.Name]
+
+		if !ok {
+			listeners = t.listenersFor(e.Name)
+			resolved[e.Name] = listeners
+		}
+
+		emitter.deliverFrom(ctx, t, e.Name, e.Args, listeners, dispatch)
+	}
+
+	mutex.Lock()
+	flushed = true
+	mutex.Unlock()
+
+	for _, l := range order {
+		batch := batches[l]
+		emitter.call(context.WithValue(context.Background(), batchKey{}, batch), batch[0].Name, l, nil)
+	}
+
+	return emitter
+}
+
+// batchOf returns the batch of Events held by ctx, or else the envelope of
+// the emission of the event with the arguments alone.
+func batchOf(ctx context.Context, event interface{}, arguments []interface{}) []Event {
+	if nil != ctx {
+		if batch, ok := ctx.Value(batchKey{}).([]Event); ok {
+			return batch
+		}
+	}
+
+	return []Event{envelope(ctx, event, arguments)}
+}
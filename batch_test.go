@@ -0,0 +1,70 @@
+package emission
+
+import (
+	"testing"
+)
+
+func TestEmitBatch(t *testing.T) {
+	received := []int{}
+	batches := [][]Event{}
+
+	NewEmitter().
+		On("a", func(n int) { received = append(received, n) }).
+		On("a", func(batch []Event) { batches = append(batches, batch) }).
+		On("b", func(batch []Event) { batches = append(batches, batch) }).
+		EmitBatch([]Event{
+			{Name: "a", Args: []interface{}{1}},
+			{Name: "b", Args: []interface{}{2}},
+			{Name: "a", Args: []interface{}{3}},
+		})
+
+	if 2 != len(received) || 1 != received[0] || 3 != received[1] {
+		t.Error("Failed to call the listeners of each Event.", received)
+	}
+
+	if 2 != len(batches) || 2 != len(batches[0]) || 1 != len(batches[1]) {
+		t.Fatal("Failed to call the batch listeners once with their Events.", batches)
+	}
+
+	if 1 != batches[0][0].Args[0] || 3 != batches[0][1].Args[0] || "b" != batches[1][0].Name {
+		t.Error("Failed to deliver the Events of the batch in order.", batches)
+	}
+}
+
+func TestBatchListener(t *testing.T) {
+	var received []Event
+
+	NewEmitter().
+		On("test", func(batch []Event) { received = batch }).
+		EmitSync("test", 1)
+
+	if 1 != len(received) || "test" != received[0].Name || 1 != received[0].Args[0] {
+		t.Error("Failed to call the batch listener with the envelope of the emission.", received)
+	}
+}
+
+func TestEmitBatchSnapshot(t *testing.T) {
+	emitter := NewEmitter()
+	added, once := 0, 0
+
+	emitter.
+		Once("a", func() { once++ }).
+		On("a", func() {
+			emitter.On("a", func() { added++ })
+		}).
+		EmitBatch([]Event{{Name: "a"}, {Name: "a"}})
+
+	if 0 != added {
+		t.Error("Failed to resolve the listeners once for the batch.", added)
+	}
+
+	if 1 != once {
+		t.Error("Failed to call a listener added with Once only once.", once)
+	}
+
+	emitter.EmitSync("a")
+
+	if 2 != added {
+		t.Error("Failed to add listeners from within the batch.", added)
+	}
+}
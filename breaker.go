@@ -0,0 +1,61 @@
+package emission
+
+import (
+	"time"
+)
+
+// ListenerTrippedEvent is the meta-event emitted synchronously when a
+// listener's circuit breaker trips, supplying listeners with the event and
+// the listener function.
+const ListenerTrippedEvent metaEvent = "listenerTripped"
+
+// SetCircuitBreaker enables a circuit breaker per listener when threshold is
+// greater than 0, the default being 0. A listener panicking more than
+// threshold times within the window is paused, as PauseListener would pause
+// it, and the ListenerTrippedEvent is emitted. ResumeListener closes the
+// breaker again. Panics are otherwise handled as before, so the breaker is
+// best used along with a RecoveryListener.
+func (emitter *Emitter) SetCircuitBreaker(threshold int, window time.Duration) *Emitter {
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	emitter.breakerThreshold = threshold
+	emitter.breakerWindow = window
+	emitter.publish()
+	return emitter
+}
+
+// fail records a panic of the listener, tripping its circuit breaker if it
+// has panicked more than the threshold within the window.
+func (emitter *Emitter) fail(l *listenerRecord) {
+	now := time.Now()
+
+	emitter.Lock()
+
+	if 0 >= emitter.breakerThreshold || l.paused.Load() {
+		emitter.Unlock()
+		return
+	}
+
+	failures := []time.Time{}
+
+	for _, failure := range l.failures {
+		if now.Sub(failure) < emitter.breakerWindow {
+			failures = append(failures, failure)
+		}
+	}
+
+	l.failures = append(failures, now)
+	tripped := emitter.breakerThreshold < len(l.failures)
+
+	if tripped {
+		l.paused.Store(true)
+		l.failures = nil
+	}
+
+	emitter.Unlock()
+
+	if tripped {
+		emitter.notify(ListenerTrippedEvent, l)
+	}
+}
@@ -0,0 +1,40 @@
+package emission
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSetCircuitBreaker(t *testing.T) {
+	event := "test"
+	calls, trips := 0, 0
+
+	emitter := NewEmitter().
+		SetCircuitBreaker(2, time.Minute).
+		RecoverWith(func(event, listener interface{}, err error) {}).
+		On(ListenerTrippedEvent, func(event, listener interface{}) { trips++ }).
+		On(event, func() {
+			calls++
+			panic("test")
+		})
+
+	for i := 0; i < 5; i++ {
+		emitter.EmitSync(event)
+	}
+
+	if 3 != calls || 1 != trips {
+		t.Error("Failed to trip the circuit breaker once the threshold was exceeded.", calls, trips)
+	}
+
+	infos := emitter.Listeners(event)
+
+	if !infos[0].Paused {
+		t.Error("Failed to pause the tripped listener.")
+	}
+
+	emitter.ResumeListener(infos[0].Handle).EmitSync(event)
+
+	if 4 != calls {
+		t.Error("Failed to resume the tripped listener.", calls)
+	}
+}
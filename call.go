@@ -0,0 +1,114 @@
+package emission
+
+import (
+	"errors"
+	"reflect"
+	"runtime/debug"
+)
+
+// Error presented when a handler is set for an event which already has one.
+var ErrHandlerExists = errors.New("Event already has a handler.")
+
+// Error returned by Call when an event has no handler.
+var ErrNoHandler = errors.New("Event has no handler.")
+
+// Handle sets the handler Call invokes for the event. Unlike listeners, an
+// event has at most one handler. If the event already has a handler or the
+// handler is not a function, Handle panics with ErrHandlerExists or
+// ErrNoneFunction. If a RecoveryListener has been set then it is called
+// with the error instead.
+func (emitter *Emitter) Handle(event, handler interface{}) *Emitter {
+	if emitter.rejectEvent(event) {
+		return emitter
+	}
+
+	emitter.lazyInit()
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	fn := reflect.ValueOf(handler)
+	_, exists := emitter.handlers[event]
+
+	var err error
+
+	switch {
+	case emitter.closed:
+		err = ErrClosed
+	case reflect.Func != fn.Kind():
+		err = ErrNoneFunction
+	case exists:
+		err = ErrHandlerExists
+	}
+
+	if nil != err {
+		recoverer := emitter.recovery()
+
+		if nil == recoverer {
+			panic(err)
+		}
+
+		recoverer(event, handler, err)
+		return emitter
+	}
+
+	record := &listenerRecord{event: event}
+	record.setFunc(fn)
+
+	emitter.handlers[event] = record
+	return emitter
+}
+
+// RemoveHandler removes the event's handler, if any.
+func (emitter *Emitter) RemoveHandler(event interface{}) *Emitter {
+	if emitter.rejectEvent(event) {
+		return emitter
+	}
+
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	delete(emitter.handlers, event)
+	return emitter
+}
+
+// Call synchronously invokes the event's handler with the arguments,
+// returning the values it returns. ErrNoHandler is returned if the event
+// has no handler, ErrInvalidEvent if it cannot be used as a key, or
+// ErrClosed if the Emitter is closed. The arguments are marshaled into the
+// handler's parameters as they are for listeners, and a handler whose first
+// parameter is a context.Context is passed one as listeners are. If the
+// handler panics, as it does when the arguments do not align with its
+// parameters, the panic is returned as a *PanicError. Calls bypass the
+// Emitter's listeners, hooks and middleware.
+func (emitter *Emitter) Call(event interface{}, arguments ...interface{}) (results []interface{}, err error) {
+	if err := checkEvent(event); nil != err {
+		return nil, err
+	}
+
+	emitter.RLock()
+	l, ok := emitter.handlers[event]
+	closed := emitter.closed
+	emitter.RUnlock()
+
+	if closed {
+		return nil, ErrClosed
+	}
+
+	if !ok {
+		return nil, ErrNoHandler
+	}
+
+	defer func() {
+		if r := recover(); nil != r {
+			results, err = nil, &PanicError{Value: r, Stack: debug.Stack()}
+		}
+	}()
+
+	values := l.marshal(nil, l.contextual(nil, event, arguments), emitter.load().marshaling)
+
+	for _, value := range l.fn.Call(values) {
+		results = append(results, value.Interface())
+	}
+
+	return results, nil
+}
@@ -0,0 +1,56 @@
+package emission
+
+import (
+	"context"
+	"testing"
+)
+
+func TestCall(t *testing.T) {
+	event := "test"
+	emitter := NewEmitter()
+
+	if _, err := emitter.Call(event); ErrNoHandler != err {
+		t.Error("Failed to return ErrNoHandler for an event without a handler.", err)
+	}
+
+	emitter.Handle(event, func(a, b int) (int, error) { return a + b, nil })
+
+	results, err := emitter.Call(event, 1, 2)
+
+	if nil != err || 2 != len(results) || 3 != results[0] || nil != results[1] {
+		t.Error("Failed to return the handler's return values.", results, err)
+	}
+
+	if _, err := emitter.Call(event, "a"); nil == err {
+		t.Error("Failed to return an error for misaligned arguments.")
+	}
+
+	var recovered error
+
+	emitter.RecoverWith(func(event, handler interface{}, err error) {
+		recovered = err
+	}).Handle(event, func() {})
+
+	if ErrHandlerExists != recovered {
+		t.Error("Failed to refuse a second handler for the event.", recovered)
+	}
+
+	emitter.RemoveHandler(event)
+
+	if _, err := emitter.Call(event, 1, 2); ErrNoHandler != err {
+		t.Error("Failed to remove the handler.", err)
+	}
+}
+
+func TestCallMarshaled(t *testing.T) {
+	event := "test"
+
+	emitter := NewEmitter().
+		Handle(event, func(ctx context.Context, n int64, rest ...string) bool {
+			return nil != ctx && 7 == n && 1 == len(rest) && "" == rest[0]
+		})
+
+	if results, err := emitter.Call(event, 7, nil); nil != err || true != results[0] {
+		t.Error("Failed to marshal the arguments into the handler's parameters as for listeners.", results, err)
+	}
+}
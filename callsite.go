@@ -0,0 +1,45 @@
+package emission
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// Directory of the package's source files, used to find the first caller
+// outside of the package.
+var packageDir = func() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Dir(file)
+}()
+
+// SetCallSites sets whether the call site adding each listener, the file
+// and line of the first caller outside of the package, is captured to
+// describe the listener in ListenerInfo and Dump.
+func (emitter *Emitter) SetCallSites(enabled bool) *Emitter {
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	emitter.callSites = enabled
+	return emitter
+}
+
+// callSite returns the file and line of the first caller outside of the
+// package, or "" if there is none.
+func callSite() string {
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+
+	for {
+		frame, more := frames.Next()
+
+		if packageDir != filepath.Dir(frame.File) || strings.HasSuffix(frame.File, "_test.go") {
+			return fmt.Sprintf("%s:%d", frame.File, frame.Line)
+		}
+
+		if !more {
+			return ""
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package emission
+
+import "context"
+
+// EmitAsyncContext calls each listener of the event within its own go
+// routine as EmitAsync does, returning a function which cancels the
+// emission. Listeners accepting a context.Context are passed a context
+// derived from ctx which is done once the emission is canceled or ctx is
+// done, after which listeners yet to be called are skipped. As with
+// context.WithCancel, the cancel function should be called once the
+// emission is no longer needed to release the resources of its context.
+func (emitter *Emitter) EmitAsyncContext(ctx context.Context, event interface{}, arguments ...interface{}) context.CancelFunc {
+	ctx, cancel := context.WithCancel(ctx)
+
+	emitter.emit(ctx, Event{Name: event, Args: arguments}, func(ctx context.Context, event interface{}, listeners []*listenerRecord, arguments []interface{}) {
+		for _, l := range listeners {
+			if nil != ctx.Err() {
+				return
+			}
+
+			l := l
+
+			emitter.spawn(event, l, func() {
+				if nil != ctx.Err() {
+					return
+				}
+
+				emitter.call(ctx, event, l, arguments)
+			})
+		}
+	})
+
+	return cancel
+}
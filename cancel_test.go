@@ -0,0 +1,45 @@
+package emission
+
+import (
+	"context"
+	"testing"
+)
+
+type queueingDispatcher struct {
+	jobs []func()
+}
+
+func (d *queueingDispatcher) Dispatch(job func()) {
+	d.jobs = append(d.jobs, job)
+}
+
+func TestEmitAsyncContext(t *testing.T) {
+	event := "test"
+	dispatcher := &queueingDispatcher{}
+	var (
+		cancel   context.CancelFunc
+		received error
+	)
+	invoked := false
+
+	cancel = NewEmitter().
+		SetDispatcher(dispatcher).
+		AddListener(event, func(ctx context.Context) {
+			cancel()
+			received = ctx.Err()
+		}).
+		AddListener(event, func() { invoked = true }).
+		EmitAsyncContext(context.Background(), event)
+
+	for _, job := range dispatcher.jobs {
+		job()
+	}
+
+	if context.Canceled != received {
+		t.Error("Failed to signal the running listener.", received)
+	}
+
+	if invoked {
+		t.Error("Failed to skip the listener yet to be called.")
+	}
+}
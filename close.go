@@ -0,0 +1,94 @@
+package emission
+
+import (
+	"errors"
+)
+
+// Error presented when a closed Emitter is used.
+var ErrClosed = errors.New("Emitter is closed.")
+
+// Close closes the Emitter, waiting for listeners running within their own
+// go routine to return before removing every listener and releasing the
+// Emitter's resources. Once closed, adding a listener panics with ErrClosed,
+// or calls the RecoveryListener with it if one has been set, and emitting
+// an event does nothing. ErrClosed is returned if the Emitter is already
+// closed.
+//
+// Close must not be called by a listener running within its own go
+// routine, as by Emit, since Close would wait for that listener to return
+// and never return itself. Such listeners, for example one shutting down
+// the Emitter on a "quit" event, should call CloseNow instead.
+func (emitter *Emitter) Close() error {
+	return emitter.close(true)
+}
+
+// CloseNow closes the Emitter as Close does, but without waiting for
+// listeners running within their own go routine, which are left to return
+// on their own. Unlike Close, it may be called by any listener.
+func (emitter *Emitter) CloseNow() error {
+	return emitter.close(false)
+}
+
+// close closes the Emitter, waiting for listeners running within their own
+// go routine to return first if wait is true.
+func (emitter *Emitter) close(wait bool) error {
+	emitter.Lock()
+
+	if emitter.closed {
+		emitter.Unlock()
+		return ErrClosed
+	}
+
+	emitter.closed = true
+	emitter.publish()
+	emitter.Unlock()
+
+	if wait {
+		emitter.Wait()
+	}
+
+	emitter.Lock()
+	defer releaseAll(emitter.handles)
+	defer emitter.Unlock()
+
+	emitter.modify(func(t *table) {
+		*t = table{events: make(map[interface{}][]*listenerRecord), settings: t.settings}
+	})
+
+	emitter.handles = make(map[Handle]*listenerRecord)
+	emitter.middleware = nil
+	emitter.beforeHooks, emitter.afterHooks = nil, nil
+	emitter.sticky = make(map[interface{}][]interface{})
+	emitter.histories = make(map[interface{}]*history)
+	emitter.handlers = make(map[interface{}]*listenerRecord)
+
+	for _, e := range emitter.buffered {
+		discard(e.ctx)
+	}
+
+	emitter.buffered = nil
+	emitter.coalesced = nil
+	emitter.deduped = nil
+	emitter.schedules = nil
+
+	emitter.forgetSequences()
+
+	emitter.stopScheduler()
+	emitter.stopDebouncers()
+	emitter.stopThrottlers()
+	emitter.stopPool()
+	emitter.dispatcher = nil
+
+	if nil != emitter.loop {
+		emitter.loop.stop()
+		emitter.loop = nil
+	}
+
+	emitter.publish()
+	return nil
+}
+
+// isClosed reports whether the Emitter has been closed.
+func (emitter *Emitter) isClosed() bool {
+	return emitter.load().closed
+}
@@ -0,0 +1,58 @@
+package emission
+
+import (
+	"testing"
+	"time"
+)
+
+func TestClose(t *testing.T) {
+	event := "test"
+	flag := false
+
+	emitter := NewEmitter().
+		AddListener(event, func() { flag = true })
+
+	if nil != emitter.Close() || ErrClosed != emitter.Close() {
+		t.Error("Close failed to return ErrClosed once closed.")
+	}
+
+	emitter.Emit(event)
+
+	if flag || ErrClosed != emitter.TryEmit(event) {
+		t.Error("Emitted an event on a closed emitter.")
+	}
+
+	defer func() {
+		if ErrClosed != recover() {
+			t.Error("Failed to panic when adding a listener to a closed emitter.")
+		}
+	}()
+
+	emitter.AddListener(event, func() {})
+}
+
+func TestCloseNowFromListener(t *testing.T) {
+	event := "quit"
+	var err error
+
+	emitter := NewEmitter()
+	emitter.AddListener(event, func() { err = emitter.CloseNow() })
+
+	done := make(chan struct{})
+
+	go func() {
+		emitter.Emit(event)
+		emitter.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("CloseNow failed to return when called by a listener.")
+	}
+
+	if nil != err || ErrClosed != emitter.Close() {
+		t.Error("CloseNow failed to close the Emitter.", err)
+	}
+}
@@ -0,0 +1,78 @@
+package emission
+
+import "reflect"
+
+// SetCoalescing sets whether emissions of the event are coalesced. An
+// emission of a coalesced event is merged into an emission of it with equal
+// arguments, as determined by reflect.DeepEqual, which has yet to return,
+// its listeners only being called once. Emissions by EmitAsync return once
+// their listeners have been dispatched, rather than once they return.
+func (emitter *Emitter) SetCoalescing(event interface{}, enabled bool) *Emitter {
+	if emitter.rejectEvent(event) {
+		return emitter
+	}
+
+	emitter.Lock()
+	defer emitter.Unlock()
+	defer emitter.publish()
+
+	if !enabled {
+		delete(emitter.coalesced, event)
+		return emitter
+	}
+
+	if nil == emitter.coalesced {
+		emitter.coalesced = make(map[interface{}][][]interface{})
+	}
+
+	if _, ok := emitter.coalesced[event]; !ok {
+		emitter.coalesced[event] = nil
+	}
+
+	return emitter
+}
+
+// coalesce reports whether the emission of the event with the arguments is
+// merged into one in flight. If the event is coalesced and the emission is
+// not merged, it is counted as in flight until the returned function is
+// called.
+func (emitter *Emitter) coalesce(event interface{}, arguments []interface{}) (done func(), merged bool) {
+	if !emitter.load().coalescing {
+		return nil, false
+	}
+
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	inflight, ok := emitter.coalesced[event]
+
+	if !ok {
+		return nil, false
+	}
+
+	for _, other := range inflight {
+		if reflect.DeepEqual(arguments, other) {
+			return nil, true
+		}
+	}
+
+	emitter.coalesced[event] = append(inflight, arguments)
+
+	return func() {
+		emitter.Lock()
+		defer emitter.Unlock()
+
+		inflight, ok := emitter.coalesced[event]
+
+		if !ok {
+			return
+		}
+
+		for i, other := range inflight {
+			if reflect.DeepEqual(arguments, other) {
+				emitter.coalesced[event] = append(inflight[:i:i], inflight[i+1:]...)
+				return
+			}
+		}
+	}, false
+}
@@ -0,0 +1,60 @@
+package emission
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestSetCoalescing(t *testing.T) {
+	event := "test"
+	started := make(chan struct{})
+	proceed := make(chan struct{})
+	var (
+		mutex sync.Mutex
+		once  sync.Once
+	)
+	received := []string{}
+
+	emitter := NewEmitter().
+		SetCoalescing(event, true).
+		AddListener(event, func(key string) {
+			mutex.Lock()
+			received = append(received, key)
+			mutex.Unlock()
+
+			if "a" == key {
+				once.Do(func() {
+					close(started)
+					<-proceed
+				})
+			}
+		})
+
+	done := make(chan struct{})
+
+	go func() {
+		emitter.EmitSync(event, "a")
+		close(done)
+	}()
+
+	<-started
+	emitter.EmitSync(event, "a").EmitSync(event, "b")
+	close(proceed)
+	<-done
+
+	if 2 != len(received) || "a" != received[0] || "b" != received[1] {
+		t.Error("Failed to coalesce the identical emission in flight.", received)
+	}
+
+	emitter.EmitSync(event, "a")
+
+	if 3 != len(received) {
+		t.Error("Coalesced an emission once the identical emission had returned.", received)
+	}
+
+	emitter.SetCoalescing(event, false)
+
+	if 0 != len(emitter.coalesced) {
+		t.Error("Failed to stop coalescing the event.")
+	}
+}
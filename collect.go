@@ -0,0 +1,33 @@
+package emission
+
+import "context"
+
+// EmitCollect synchronously calls each listener of the event as EmitSync
+// does, returning the values returned by each listener called, in the order
+// they were called. Listeners with the canonical func(...interface{})
+// signature return no values. So that the values are returned, the emission
+// is never debounced, throttled, coalesced, buffered while the Emitter is
+// paused or queued on its event loop.
+func (emitter *Emitter) EmitCollect(event interface{}, arguments ...interface{}) [][]interface{} {
+	var collected [][]interface{}
+
+	emitter.emitNow(nil, Event{Name: event, Args: arguments}, func(ctx context.Context, event interface{}, listeners []*listenerRecord, arguments []interface{}) {
+		for _, l := range listeners {
+			results, called := emitter.call(ctx, event, l, arguments)
+
+			if !called {
+				continue
+			}
+
+			values := make([]interface{}, len(results))
+
+			for i, result := range results {
+				values[i] = result.Interface()
+			}
+
+			collected = append(collected, values)
+		}
+	})
+
+	return collected
+}
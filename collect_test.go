@@ -0,0 +1,27 @@
+package emission
+
+import (
+	"testing"
+)
+
+func TestEmitCollect(t *testing.T) {
+	event := "test"
+
+	collected := NewEmitter().
+		On(event, func(n int) int { return n * 2 }).
+		On(event, func(n int) (int, string) { return n * 3, "b" }).
+		OnFiltered(event, func(...interface{}) bool { return false }, func(n int) int { return n }).
+		EmitCollect(event, 2)
+
+	if 2 != len(collected) {
+		t.Fatal("Failed to collect the return values of each listener called.", collected)
+	}
+
+	if 1 != len(collected[0]) || 4 != collected[0][0] {
+		t.Error("Failed to collect the first listener's return values.", collected[0])
+	}
+
+	if 2 != len(collected[1]) || 6 != collected[1][0] || "b" != collected[1][1] {
+		t.Error("Failed to collect the second listener's return values.", collected[1])
+	}
+}
@@ -0,0 +1,62 @@
+package emission
+
+import (
+	"context"
+	"reflect"
+)
+
+// Type of context.Context used to detect listeners accepting a context.
+var contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+
+// EmitContext attempts to use the reflect package to Call each listener
+// stored in the Emitter's events map with the supplied arguments. Each
+// listener is called synchronously, and listeners whose first parameter
+// is a context.Context are passed a context derived from ctx ahead of the
+// arguments, unless the arguments already begin with a context. If ctx is
+// canceled, the remaining listeners are not called. If a RecoveryListener
+// has been set then it is called after recovering from a panic.
+func (emitter *Emitter) EmitContext(ctx context.Context, event interface{}, arguments ...interface{}) *Emitter {
+	emitter.emit(ctx, Event{Name: event, Args: arguments}, emitter.canceling)
+	return emitter
+}
+
+// contextual prepends the context a listener accepting one is called with
+// to the arguments, following the event if the listener was added for it.
+// If the emitted arguments already begin with a context.Context, as when
+// a context is emitted explicitly, that context is passed in its place, else
+// ctx is, or context.Background if ctx is nil.
+func (l *listenerRecord) contextual(ctx context.Context, event interface{}, arguments []interface{}) []interface{} {
+	prefix := []interface{}{}
+
+	if l.takesContext {
+		if 0 != len(arguments) && nil != arguments[0] && reflect.TypeOf(arguments[0]).Implements(contextType) {
+			prefix, arguments = append(prefix, arguments[0]), arguments[1:]
+		} else if nil == ctx {
+			prefix = append(prefix, context.Background())
+		} else {
+			prefix = append(prefix, ctx)
+		}
+	}
+
+	if l.withEvent {
+		prefix = append(prefix, event)
+	}
+
+	if 0 == len(prefix) {
+		return arguments
+	}
+
+	return append(prefix, arguments...)
+}
+
+// canceling calls each listener synchronously, in order, until ctx is
+// canceled.
+func (emitter *Emitter) canceling(ctx context.Context, event interface{}, listeners []*listenerRecord, arguments []interface{}) {
+	for _, l := range listeners {
+		if nil != ctx.Err() {
+			break
+		}
+
+		emitter.call(ctx, event, l, arguments)
+	}
+}
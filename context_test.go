@@ -0,0 +1,99 @@
+package emission
+
+import (
+	"context"
+	"sync"
+	"testing"
+)
+
+type contextKey struct{}
+
+func TestEmitContext(t *testing.T) {
+	event := "test"
+	ctx := context.WithValue(context.Background(), contextKey{}, "value")
+	received := ""
+
+	NewEmitter().
+		AddListener(event, func(ctx context.Context, s string) {
+			received = ctx.Value(contextKey{}).(string) + s
+		}).
+		EmitContext(ctx, event, "!")
+
+	if "value!" != received {
+		t.Error("EmitContext failed to pass the context to the listener.")
+	}
+}
+
+func TestEmitContextCanceled(t *testing.T) {
+	event := "test"
+	ctx, cancel := context.WithCancel(context.Background())
+	invoked := 0
+
+	NewEmitter().
+		AddListener(event, func() {
+			invoked = invoked + 1
+			cancel()
+		}).
+		AddListener(event, func() {
+			invoked = invoked + 1
+		}).
+		EmitContext(ctx, event)
+
+	if 1 != invoked {
+		t.Error("EmitContext failed to stop calling listeners after cancelation.")
+	}
+}
+
+func TestContextInjected(t *testing.T) {
+	event := "test"
+	var mutex sync.Mutex
+	received := 0
+
+	listener := func(ctx context.Context, n int) {
+		if nil == ctx {
+			t.Error("Failed to pass a context to the listener.")
+		}
+
+		mutex.Lock()
+		received += n
+		mutex.Unlock()
+	}
+
+	emitter := NewEmitter().
+		EmitSticky(event, 1).
+		AddListener(event, listener)
+
+	emitter.Emit(event, 2).EmitSync(event, 3)
+	<-emitter.EmitFuture(event, 4)
+
+	if 10 != received {
+		t.Error("Failed to call the listener accepting a context on every emission.", received)
+	}
+
+	if err := emitter.TryEmit(event, 5); nil != err {
+		t.Error("Failed to accept the arguments of a listener accepting a context.", err)
+	}
+}
+
+type valueKey struct{}
+
+func TestContextEmitted(t *testing.T) {
+	event := "test"
+	ctx := context.WithValue(context.Background(), valueKey{}, "value")
+	received := []interface{}{}
+
+	emitter := NewEmitter().
+		AddListener(event, func(ctx context.Context, n int) {
+			received = append(received, ctx.Value(valueKey{}), n)
+		})
+
+	emitter.EmitSync(event, ctx, 7).EmitContext(context.Background(), event, ctx, 8)
+
+	if 4 != len(received) || "value" != received[0] || 7 != received[1] || "value" != received[2] || 8 != received[3] {
+		t.Error("Failed to pass the context emitted to the listener.", received)
+	}
+
+	if err := emitter.TryEmit(event, ctx, 9); nil != err {
+		t.Error("Failed to accept a context emitted to a listener accepting one.", err)
+	}
+}
@@ -0,0 +1,29 @@
+package emission
+
+import "context"
+
+// correlationKey is the context key a correlation ID is stored under.
+type correlationKey struct{}
+
+// WithCorrelationID returns a copy of ctx holding the correlation ID. Events
+// emitted with the context by EmitContext are stamped with it, and so are
+// events emitted with the context passed to their listeners, correlating
+// every emission that follows from the first.
+func WithCorrelationID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, correlationKey{}, id)
+}
+
+// CorrelationID returns the correlation ID held by ctx, either set by
+// WithCorrelationID or stamped on the Event of the emission whose listener
+// was passed ctx, or "" if there is none.
+func CorrelationID(ctx context.Context) string {
+	if id, ok := ctx.Value(correlationKey{}).(string); ok {
+		return id
+	}
+
+	if e, ok := EventFromContext(ctx); ok {
+		return e.CorrelationID
+	}
+
+	return ""
+}
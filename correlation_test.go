@@ -0,0 +1,41 @@
+package emission
+
+import (
+	"context"
+	"testing"
+)
+
+func TestCorrelationID(t *testing.T) {
+	var first, second string
+
+	emitter := NewEmitter()
+
+	emitter.
+		On("first", func(ctx context.Context) {
+			first = CorrelationID(ctx)
+			emitter.EmitContext(ctx, "second")
+		}).
+		On("second", func(e Event) { second = e.CorrelationID })
+
+	emitter.EmitContext(WithCorrelationID(context.Background(), "request"), "first")
+
+	if "request" != first {
+		t.Error("Failed to pass the correlation ID to the listener.", first)
+	}
+
+	if "request" != second {
+		t.Error("Failed to propagate the correlation ID to emissions by listeners.", second)
+	}
+}
+
+func TestCorrelationIDNone(t *testing.T) {
+	var received string
+
+	NewEmitter().
+		On("test", func(ctx context.Context) { received = CorrelationID(ctx) }).
+		Emit("test")
+
+	if "" != received {
+		t.Error("Failed to leave the correlation ID unset.", received)
+	}
+}
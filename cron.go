@@ -0,0 +1,150 @@
+package emission
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Error presented when a cron expression cannot be parsed.
+var ErrInvalidSchedule = errors.New("Invalid cron expression.")
+
+// cron is a parsed cron expression, each field a set of the values it
+// matches.
+type cron struct {
+	minute, hour, dom, month, dow uint64
+	// Whether the day of month or week is unrestricted, in which case a
+	// day must only match the other.
+	anyDom, anyDow bool
+}
+
+// bounds of a field of a cron expression.
+type bounds struct {
+	min, max int
+}
+
+// Bounds of the minute, hour, day of month, month and day of week fields.
+var cronBounds = []bounds{{0, 59}, {0, 23}, {1, 31}, {1, 12}, {0, 7}}
+
+// parseCron parses a cron expression of five fields separated by spaces:
+// minute, hour, day of month, month and day of week, where both 0 and 7
+// are Sunday. Each field is a comma separated list of values, ranges such
+// as 1-5 or *, optionally followed by a step such as */15.
+func parseCron(expression string) (*cron, error) {
+	fields := strings.Fields(expression)
+
+	if 5 != len(fields) {
+		return nil, ErrInvalidSchedule
+	}
+
+	sets := make([]uint64, len(fields))
+
+	for i, field := range fields {
+		set, err := parseCronField(field, cronBounds[i])
+
+		if nil != err {
+			return nil, err
+		}
+
+		sets[i] = set
+	}
+
+	// Sunday may be written as either 0 or 7.
+	if 0 != sets[4]&(1<<7) {
+		sets[4] |= 1
+	}
+
+	return &cron{
+		minute: sets[0],
+		hour:   sets[1],
+		dom:    sets[2],
+		month:  sets[3],
+		dow:    sets[4],
+		anyDom: "*" == fields[2],
+		anyDow: "*" == fields[4],
+	}, nil
+}
+
+// parseCronField parses a field of a cron expression within the bounds,
+// returning the set of values it matches.
+func parseCronField(field string, b bounds) (set uint64, err error) {
+	for _, part := range strings.Split(field, ",") {
+		step := 1
+		low, high := b.min, b.max
+
+		if i := strings.Index(part, "/"); -1 != i {
+			if step, err = strconv.Atoi(part[i+1:]); nil != err || 0 >= step {
+				return 0, ErrInvalidSchedule
+			}
+
+			part = part[:i]
+		}
+
+		switch i := strings.Index(part, "-"); {
+		case "*" == part:
+		case -1 != i:
+			if low, err = strconv.Atoi(part[:i]); nil != err {
+				return 0, ErrInvalidSchedule
+			}
+
+			if high, err = strconv.Atoi(part[i+1:]); nil != err {
+				return 0, ErrInvalidSchedule
+			}
+		default:
+			if low, err = strconv.Atoi(part); nil != err {
+				return 0, ErrInvalidSchedule
+			}
+
+			high = low
+		}
+
+		if low < b.min || high > b.max || low > high {
+			return 0, ErrInvalidSchedule
+		}
+
+		for value := low; value <= high; value += step {
+			set |= 1 << uint(value)
+		}
+	}
+
+	return set, nil
+}
+
+// next returns the first time after t matched by the cron expression, in
+// t's location, or the zero time if there is none within five years.
+func (c *cron) next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+
+	for t.Before(limit) {
+		switch {
+		case 0 == c.month&(1<<uint(t.Month())):
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+		case !c.matchesDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+		case 0 == c.hour&(1<<uint(t.Hour())):
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+		case 0 == c.minute&(1<<uint(t.Minute())):
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+
+	return time.Time{}
+}
+
+// matchesDay reports whether the day of t is matched by the cron
+// expression. When both the day of month and week are restricted, a day
+// matching either is matched.
+func (c *cron) matchesDay(t time.Time) bool {
+	dom := 0 != c.dom&(1<<uint(t.Day()))
+	dow := 0 != c.dow&(1<<uint(t.Weekday()))
+
+	if c.anyDom || c.anyDow {
+		return dom && dow
+	}
+
+	return dom || dow
+}
@@ -0,0 +1,41 @@
+package emission
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCron(t *testing.T) {
+	for _, expression := range []string{"* * * * *", "*/15 0-6,12 1 1-12/2 1-5", "0 0 * * 7"} {
+		if _, err := parseCron(expression); nil != err {
+			t.Error("Failed to parse the cron expression.", expression, err)
+		}
+	}
+
+	for _, expression := range []string{"", "* * * *", "60 * * * *", "* * 0 * *", "5-1 * * * *", "*/0 * * * *", "a * * * *"} {
+		if _, err := parseCron(expression); ErrInvalidSchedule != err {
+			t.Error("Failed to reject the invalid cron expression.", expression, err)
+		}
+	}
+}
+
+func TestCronNext(t *testing.T) {
+	from := time.Date(2024, time.January, 31, 10, 30, 15, 0, time.UTC)
+
+	for expression, expected := range map[string]time.Time{
+		"* * * * *":     time.Date(2024, time.January, 31, 10, 31, 0, 0, time.UTC),
+		"0 * * * *":     time.Date(2024, time.January, 31, 11, 0, 0, 0, time.UTC),
+		"15 9 * * *":    time.Date(2024, time.February, 1, 9, 15, 0, 0, time.UTC),
+		"0 0 29 2 *":    time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC),
+		"0 0 * * 0":     time.Date(2024, time.February, 4, 0, 0, 0, 0, time.UTC),
+		"0 0 * * 7":     time.Date(2024, time.February, 4, 0, 0, 0, 0, time.UTC),
+		"0 12 15 * 5":   time.Date(2024, time.February, 2, 12, 0, 0, 0, time.UTC),
+		"*/20 10 * * *": time.Date(2024, time.January, 31, 10, 40, 0, 0, time.UTC),
+	} {
+		c, _ := parseCron(expression)
+
+		if next := c.next(from); !expected.Equal(next) {
+			t.Error("Failed to find the next time matched by the cron expression.", expression, next)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package emission
+
+import "time"
+
+// debouncer holds back the emissions of a debounced event.
+type debouncer struct {
+	// Quiet period after which the latest emission is released.
+	window time.Duration
+	// Latest emission held back, if any.
+	latest *emission
+	// Timer releasing the latest emission.
+	timer *time.Timer
+}
+
+// SetDebounce debounces the event, collapsing each burst of its emissions
+// into a single emission with the arguments of the latest, released within
+// a go routine of its own once no emission of the event has occurred for
+// the window. A window of 0 or less stops debouncing the event, releasing
+// any emission held back.
+func (emitter *Emitter) SetDebounce(event interface{}, window time.Duration) *Emitter {
+	if emitter.rejectEvent(event) {
+		return emitter
+	}
+
+	emitter.Lock()
+
+	d := emitter.debouncers[event]
+
+	if 0 < window {
+		if nil == emitter.debouncers {
+			emitter.debouncers = make(map[interface{}]*debouncer)
+		}
+
+		if nil == d {
+			emitter.debouncers[event] = &debouncer{window: window}
+		} else {
+			d.window = window
+		}
+
+		emitter.publish()
+		emitter.Unlock()
+		return emitter
+	}
+
+	delete(emitter.debouncers, event)
+
+	var latest *emission
+
+	if nil != d && nil != d.latest && d.timer.Stop() {
+		latest = d.latest
+		d.latest = nil
+	}
+
+	emitter.publish()
+	emitter.Unlock()
+
+	if nil != latest {
+		emitter.release(latest.ctx, latest.event, latest.arguments, latest.dispatch)
+	}
+
+	return emitter
+}
+
+// debounce holds back the emission if its event is debounced, reporting
+// whether it was held back.
+func (emitter *Emitter) debounce(e emission) bool {
+	if !emitter.load().debouncing {
+		return false
+	}
+
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	d := emitter.debouncers[e.event]
+
+	if nil == d {
+		return false
+	}
+
+	if nil != d.latest {
+		discard(d.latest.ctx)
+	}
+
+	d.latest = &e
+
+	if nil != d.timer {
+		d.timer.Stop()
+	}
+
+	d.timer = time.AfterFunc(d.window, func() {
+		emitter.Lock()
+		latest := d.latest
+
+		if latest != &e {
+			// A later emission has replaced this one.
+			emitter.Unlock()
+			return
+		}
+
+		d.latest = nil
+		emitter.Unlock()
+
+		emitter.release(latest.ctx, latest.event, latest.arguments, latest.dispatch)
+	})
+
+	return true
+}
+
+// stopDebouncers stops debouncing every event, discarding the emissions
+// held back. It must be called with the Emitter's mutex held.
+func (emitter *Emitter) stopDebouncers() {
+	for _, d := range emitter.debouncers {
+		if nil != d.timer {
+			d.timer.Stop()
+		}
+
+		if nil != d.latest {
+			discard(d.latest.ctx)
+		}
+	}
+
+	emitter.debouncers = nil
+}
@@ -0,0 +1,49 @@
+package emission
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSetDebounce(t *testing.T) {
+	event := "test"
+	received := make(chan int, 3)
+
+	emitter := NewEmitter().
+		SetDebounce(event, 20*time.Millisecond).
+		AddListener(event, func(n int) { received <- n })
+
+	emitter.Emit(event, 1).Emit(event, 2).Emit(event, 3)
+
+	select {
+	case n := <-received:
+		if 3 != n {
+			t.Error("Failed to release the latest emission.", n)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Failed to release the debounced emission.")
+	}
+
+	select {
+	case n := <-received:
+		t.Error("Failed to collapse the burst into a single emission.", n)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestSetDebounceDisabled(t *testing.T) {
+	event := "test"
+	received := []int{}
+
+	NewEmitter().
+		SetDebounce(event, time.Hour).
+		AddListener(event, func(n int) { received = append(received, n) }).
+		EmitSync(event, 1).
+		EmitSync(event, 2).
+		SetDebounce(event, 0).
+		EmitSync(event, 3)
+
+	if 2 != len(received) || 2 != received[0] || 3 != received[1] {
+		t.Error("Failed to release the emission held back once no longer debounced.", received)
+	}
+}
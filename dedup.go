@@ -0,0 +1,78 @@
+package emission
+
+import "time"
+
+// Default window within which EmitDedup suppresses emissions sharing a key.
+const DefaultDedupWindow = time.Minute
+
+// dedupKey identifies the emissions of an event sharing a key.
+type dedupKey struct {
+	event, key interface{}
+}
+
+// SetDedupWindow sets the window within which EmitDedup suppresses
+// emissions sharing a key. A window of 0 or less restores the
+// DefaultDedupWindow.
+func (emitter *Emitter) SetDedupWindow(window time.Duration) *Emitter {
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	emitter.dedupWindow = window
+	return emitter
+}
+
+// EmitDedup emits the event with the arguments as Emit does, unless an
+// emission of the event with the same key occurred within the Emitter's
+// dedup window, in which case it is suppressed. If the event or key cannot
+// be used as a key, EmitDedup panics with ErrInvalidEvent or calls the
+// RecoveryListener with it.
+func (emitter *Emitter) EmitDedup(event, key interface{}, arguments ...interface{}) *Emitter {
+	if emitter.rejectEvent(event) || emitter.rejectEvent(key) {
+		return emitter
+	}
+
+	if emitter.seen(event, key) {
+		return emitter
+	}
+
+	return emitter.Emit(event, arguments...)
+}
+
+// seen records an emission of the event with the key, reporting whether
+// one occurred within the dedup window. Keys seen before the window are
+// forgotten at most once per window.
+func (emitter *Emitter) seen(event, key interface{}) bool {
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	window := emitter.dedupWindow
+
+	if 0 >= window {
+		window = DefaultDedupWindow
+	}
+
+	now := time.Now()
+
+	if nil == emitter.deduped {
+		emitter.deduped = make(map[dedupKey]time.Time)
+	}
+
+	if now.Sub(emitter.dedupSwept) >= window {
+		for k, at := range emitter.deduped {
+			if now.Sub(at) >= window {
+				delete(emitter.deduped, k)
+			}
+		}
+
+		emitter.dedupSwept = now
+	}
+
+	k := dedupKey{event, key}
+
+	if at, ok := emitter.deduped[k]; ok && now.Sub(at) < window {
+		return true
+	}
+
+	emitter.deduped[k] = now
+	return false
+}
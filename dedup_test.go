@@ -0,0 +1,30 @@
+package emission
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEmitDedup(t *testing.T) {
+	event := "test"
+	received := []int{}
+
+	emitter := NewEmitter().
+		SetDispatcher(SyncDispatcher{}).
+		AddListener(event, func(n int) { received = append(received, n) }).
+		EmitDedup(event, "a", 1).
+		EmitDedup(event, "a", 2).
+		EmitDedup(event, "b", 3)
+
+	if 2 != len(received) || 1 != received[0] || 3 != received[1] {
+		t.Error("Failed to suppress the emission sharing a key.", received)
+	}
+
+	emitter.SetDedupWindow(time.Millisecond)
+	time.Sleep(5 * time.Millisecond)
+	emitter.EmitDedup(event, "a", 4)
+
+	if 3 != len(received) || 4 != received[2] {
+		t.Error("Suppressed an emission once the window had elapsed.", received)
+	}
+}
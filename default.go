@@ -0,0 +1,31 @@
+package emission
+
+// DefaultEmitter is the Emitter used by the package-level functions On,
+// Once, Off, Emit and EmitSync, shared by the whole process.
+var DefaultEmitter = NewEmitter()
+
+// On adds the listener for the event to the DefaultEmitter.
+func On(event, listener interface{}) *Emitter {
+	return DefaultEmitter.On(event, listener)
+}
+
+// Once adds the listener for the event to the DefaultEmitter, to be called
+// once.
+func Once(event, listener interface{}) *Emitter {
+	return DefaultEmitter.Once(event, listener)
+}
+
+// Off removes the listener of the event from the DefaultEmitter.
+func Off(event, listener interface{}) *Emitter {
+	return DefaultEmitter.Off(event, listener)
+}
+
+// Emit emits the event on the DefaultEmitter as its Emit does.
+func Emit(event interface{}, arguments ...interface{}) *Emitter {
+	return DefaultEmitter.Emit(event, arguments...)
+}
+
+// EmitSync emits the event on the DefaultEmitter as its EmitSync does.
+func EmitSync(event interface{}, arguments ...interface{}) *Emitter {
+	return DefaultEmitter.EmitSync(event, arguments...)
+}
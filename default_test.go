@@ -0,0 +1,25 @@
+package emission
+
+import (
+	"testing"
+)
+
+func TestDefaultEmitter(t *testing.T) {
+	event := "default.test"
+	received := []int{}
+	listener := func(n int) { received = append(received, n) }
+
+	On(event, listener)
+	Once(event, func(n int) { received = append(received, -n) })
+
+	if DefaultEmitter != EmitSync(event, 1) {
+		t.Error("Failed to return the DefaultEmitter.")
+	}
+
+	Off(event, listener)
+	Emit(event, 2)
+
+	if 2 != len(received) || 1 != received[0] || -1 != received[1] {
+		t.Error("Failed to use the DefaultEmitter.", received)
+	}
+}
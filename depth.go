@@ -0,0 +1,44 @@
+package emission
+
+import (
+	"context"
+	"errors"
+)
+
+// Error presented when an emission is nested deeper than the Emitter's
+// maximum depth.
+var ErrMaxDepth = errors.New("Emission exceeds the maximum depth.")
+
+// SetMaxDepth sets the maximum number of emissions an emission may be
+// nested within, emitted with the context passed to a listener of each,
+// such as by EmitContext. Deeper emissions are dropped, panicking with
+// ErrMaxDepth or calling the RecoveryListener with it, to report emissions
+// looping endlessly. A depth of 0 or less, the default, sets no maximum.
+//
+// Depth is tracked through the context of each emission alone, so a
+// listener re-entering the Emitter without its context, as by calling
+// EmitSync or Emit, starts a new emission at depth 0. Such loops are only
+// bounded when listeners emit with the context they are passed.
+//
+// Listeners, hooks and middleware are called without the Emitter's mutex
+// held, and may emit events and add or remove listeners themselves.
+func (emitter *Emitter) SetMaxDepth(depth int) *Emitter {
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	emitter.maxDepth = depth
+	emitter.publish()
+	return emitter
+}
+
+// checkDepth returns ErrMaxDepth if the emission whose Event is held by ctx
+// is nested deeper than the Emitter's maximum depth.
+func (emitter *Emitter) checkDepth(ctx context.Context) error {
+	max := emitter.load().maxDepth
+
+	if e, _ := EventFromContext(ctx); 0 < max && e.depth > max {
+		return ErrMaxDepth
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package emission
+
+import (
+	"context"
+	"testing"
+)
+
+func TestMaxDepth(t *testing.T) {
+	var (
+		calls int
+		err   error
+	)
+
+	emitter := NewEmitter().
+		SetMaxDepth(3).
+		RecoverWith(func(event, listener interface{}, e error) { err = e })
+
+	emitter.On("loop", func(ctx context.Context) {
+		calls++
+		emitter.EmitContext(ctx, "loop")
+	})
+
+	emitter.EmitSync("loop")
+
+	if 4 != calls {
+		t.Error("Failed to stop the emission loop at the maximum depth.", calls)
+	}
+
+	if ErrMaxDepth != err {
+		t.Error("Failed to report the emission loop to the recoverer.", err)
+	}
+}
+
+func TestMaxDepthSiblings(t *testing.T) {
+	calls := 0
+
+	emitter := NewEmitter().SetMaxDepth(1)
+
+	emitter.
+		On("outer", func() {
+			for i := 0; i < 5; i++ {
+				emitter.EmitSync("inner")
+			}
+		}).
+		On("inner", func() { calls++ })
+
+	emitter.EmitSync("outer").EmitSync("outer")
+
+	if 10 != calls {
+		t.Error("Failed to allow sequential emissions within a listener.", calls)
+	}
+}
+
+func TestMaxDepthPanics(t *testing.T) {
+	emitter := NewEmitter().SetMaxDepth(1)
+
+	emitter.On("loop", func(ctx context.Context) {
+		emitter.EmitContext(ctx, "loop")
+	})
+
+	defer func() {
+		if ErrMaxDepth != recover() {
+			t.Error("Failed to panic exceeding the maximum depth.")
+		}
+	}()
+
+	emitter.EmitSync("loop")
+}
+
+func TestReentrant(t *testing.T) {
+	var received []string
+
+	emitter := NewEmitter().
+		SetMaxDepth(1).
+		Use(func(event interface{}, arguments []interface{}, next func([]interface{})) {
+			next(arguments)
+		})
+
+	var remove func()
+
+	remove = func() { emitter.Off("first", remove) }
+
+	emitter.
+		On("first", func(ctx context.Context) {
+			emitter.
+				On("second", func() { received = append(received, "second") }).
+				EmitContext(ctx, "second").
+				RemoveAllListeners("second")
+
+			received = append(received, "first")
+		}).
+		On("first", remove)
+
+	emitter.EmitSync("first")
+
+	if 2 != len(received) || "second" != received[0] || "first" != received[1] {
+		t.Error("Failed to emit and modify listeners from within a listener.", received)
+	}
+
+	if 1 != emitter.GetListenerCount("first") {
+		t.Error("Failed to remove a listener from within a listener.")
+	}
+}
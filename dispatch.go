@@ -0,0 +1,120 @@
+package emission
+
+// Dispatcher schedules the jobs calling listeners which would otherwise
+// each be called within a new go routine.
+type Dispatcher interface {
+	// Dispatch calls the job, either immediately or at a later time.
+	Dispatch(job func())
+}
+
+// GoroutineDispatcher is a Dispatcher calling each job within a new go
+// routine. It is the Emitter's default Dispatcher.
+type GoroutineDispatcher struct{}
+
+// Dispatch calls the job within a new go routine.
+func (GoroutineDispatcher) Dispatch(job func()) {
+	go job()
+}
+
+// SyncDispatcher is a Dispatcher calling each job immediately, within the
+// go routine dispatching it.
+type SyncDispatcher struct{}
+
+// Dispatch calls the job immediately.
+func (SyncDispatcher) Dispatch(job func()) {
+	job()
+}
+
+// PoolDispatcher is a Dispatcher reusing a bounded pool of go routines to
+// call jobs. When every go routine of the pool is busy, a job is called by
+// the go routine dispatching it instead, bounding the number of go routines
+// without risking deadlock when listeners emit events themselves.
+type PoolDispatcher struct {
+	// Channel jobs are handed to idle workers on.
+	jobs chan func()
+	// Channel closed to stop the workers.
+	quit chan struct{}
+}
+
+// NewPoolDispatcher returns a new PoolDispatcher, starting size go routines.
+func NewPoolDispatcher(size int) *PoolDispatcher {
+	p := &PoolDispatcher{
+		jobs: make(chan func()),
+		quit: make(chan struct{}),
+	}
+
+	for i := 0; i < size; i++ {
+		go p.work()
+	}
+
+	return p
+}
+
+// work calls jobs handed to it until the pool is stopped.
+func (p *PoolDispatcher) work() {
+	for {
+		select {
+		case job := <-p.jobs:
+			job()
+		case <-p.quit:
+			return
+		}
+	}
+}
+
+// Dispatch hands the job to an idle go routine of the pool, or calls it
+// immediately if every go routine is busy.
+func (p *PoolDispatcher) Dispatch(job func()) {
+	select {
+	case p.jobs <- job:
+	default:
+		job()
+	}
+}
+
+// Stop stops the pool's go routines once they finish their current job.
+// The PoolDispatcher must not be used afterwards.
+func (p *PoolDispatcher) Stop() {
+	close(p.quit)
+}
+
+// SetDispatcher sets the Dispatcher scheduling the jobs calling listeners
+// which would otherwise each be called within a new go routine. Passing
+// nil restores the default GoroutineDispatcher.
+func (emitter *Emitter) SetDispatcher(dispatcher Dispatcher) *Emitter {
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	emitter.stopPool()
+	emitter.dispatcher = dispatcher
+	emitter.publish()
+	return emitter
+}
+
+// SetDispatchPool sets the Emitter's Dispatcher to a PoolDispatcher of the
+// size, owned and stopped by the Emitter once replaced. A size of 0 restores
+// the default GoroutineDispatcher.
+func (emitter *Emitter) SetDispatchPool(size int) *Emitter {
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	emitter.stopPool()
+	emitter.dispatcher = nil
+
+	if 0 < size {
+		emitter.pool = NewPoolDispatcher(size)
+		emitter.dispatcher = emitter.pool
+	}
+
+	emitter.publish()
+
+	return emitter
+}
+
+// stopPool stops the PoolDispatcher set by SetDispatchPool, if any.
+func (emitter *Emitter) stopPool() {
+	if nil != emitter.pool {
+		emitter.pool.Stop()
+		emitter.pool = nil
+	}
+}
@@ -0,0 +1,82 @@
+package emission
+
+import (
+	"sync/atomic"
+	"testing"
+)
+
+func TestSetDispatchPool(t *testing.T) {
+	event := "test"
+	var invoked int32
+
+	emitter := NewEmitter().
+		SetDispatchPool(2).
+		AddListener(event, func() { atomic.AddInt32(&invoked, 1) }).
+		AddListener(event, func() { atomic.AddInt32(&invoked, 1) }).
+		AddListener(event, func() { atomic.AddInt32(&invoked, 1) }).
+		Emit(event)
+
+	if 3 != atomic.LoadInt32(&invoked) {
+		t.Error("Failed to call every listener using the dispatch pool.")
+	}
+
+	emitter.SetDispatchPool(0).Emit(event)
+
+	if 6 != atomic.LoadInt32(&invoked) {
+		t.Error("Failed to call every listener after removing the dispatch pool.")
+	}
+}
+
+func TestSetDispatchPoolReentrant(t *testing.T) {
+	emitter := NewEmitter().SetDispatchPool(1)
+	flag := false
+
+	emitter.
+		AddListener("inner", func() { flag = true }).
+		AddListener("outer", func() { emitter.Emit("inner") }).
+		Emit("outer")
+
+	if !flag {
+		t.Error("Failed to call listeners of events emitted by a pooled listener.")
+	}
+}
+
+type countingDispatcher struct {
+	dispatched int
+}
+
+func (d *countingDispatcher) Dispatch(job func()) {
+	d.dispatched = d.dispatched + 1
+	job()
+}
+
+func TestSetDispatcher(t *testing.T) {
+	event := "test"
+	dispatcher := &countingDispatcher{}
+	invoked := 0
+
+	NewEmitter().
+		SetDispatcher(dispatcher).
+		AddListener(event, func() { invoked = invoked + 1 }).
+		AddListener(event, func() { invoked = invoked + 1 }).
+		Emit(event)
+
+	if 2 != dispatcher.dispatched || 2 != invoked {
+		t.Error("Failed to call listeners using the Dispatcher.")
+	}
+}
+
+func TestSyncDispatcher(t *testing.T) {
+	event := "test"
+	received := []int{}
+
+	NewEmitter().
+		SetDispatcher(SyncDispatcher{}).
+		AddListener(event, func() { received = append(received, 1) }).
+		AddListener(event, func() { received = append(received, 2) }).
+		EmitAsync(event)
+
+	if 2 != len(received) || 1 != received[0] {
+		t.Error("Failed to call listeners immediately using the SyncDispatcher.", received)
+	}
+}
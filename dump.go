@@ -0,0 +1,86 @@
+package emission
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// Dump writes a description of the Emitter's events and their listeners to
+// the writer, for diagnosing which listeners remain added. Each listener is
+// described by its handle, name and whether it is called once, paused or
+// tagged, along with its call site if captured.
+func (emitter *Emitter) Dump(writer io.Writer) error {
+	type entry struct {
+		name  string
+		infos []ListenerInfo
+	}
+
+	var entries []entry
+	total := 0
+
+	emitter.RLock()
+
+	for event, listeners := range emitter.load().events {
+		if 0 == len(listeners) {
+			continue
+		}
+
+		e := entry{name: fmt.Sprintf("%#v", event)}
+
+		for i, l := range listeners {
+			e.infos = append(e.infos, l.info(i))
+		}
+
+		entries = append(entries, e)
+		total += len(listeners)
+	}
+
+	emitter.RUnlock()
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].name < entries[j].name
+	})
+
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Emitter: %d events, %d listeners\n", len(entries), total)
+
+	for _, e := range entries {
+		fmt.Fprintf(&b, "  %s: %d listeners\n", e.name, len(e.infos))
+
+		for _, info := range e.infos {
+			fmt.Fprintf(&b, "    #%d %s", info.Handle, info.Name)
+
+			if info.Once {
+				b.WriteString(" once")
+			}
+
+			if info.Paused {
+				b.WriteString(" paused")
+			}
+
+			if 0 != len(info.Tags) {
+				fmt.Fprintf(&b, " tags=%s", strings.Join(info.Tags, ","))
+			}
+
+			if "" != info.CallSite {
+				fmt.Fprintf(&b, " at %s", info.CallSite)
+			}
+
+			b.WriteString("\n")
+		}
+	}
+
+	_, err := io.WriteString(writer, b.String())
+	return err
+}
+
+// String describes the Emitter's events and their listeners as Dump does.
+func (emitter *Emitter) String() string {
+	var b strings.Builder
+
+	emitter.Dump(&b)
+	return b.String()
+}
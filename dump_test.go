@@ -0,0 +1,45 @@
+package emission
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDump(t *testing.T) {
+	emitter := NewEmitter().
+		SetCallSites(true).
+		OnWith("a", func() {}, WithName("first"), WithTag("billing")).
+		Once("a", func() {}).
+		On(2, func() {})
+
+	dump := emitter.String()
+
+	for _, expected := range []string{
+		"Emitter: 2 events, 3 listeners\n",
+		"  \"a\": 2 listeners\n",
+		"    #1 first tags=billing at ",
+		" once at ",
+		"  2: 1 listeners\n",
+		"dump_test.go:",
+	} {
+		if !strings.Contains(dump, expected) {
+			t.Error("Failed to describe the Emitter.", expected, dump)
+		}
+	}
+}
+
+func TestCallSites(t *testing.T) {
+	infos := NewEmitter().
+		AddListener("a", func() {}).
+		SetCallSites(true).
+		AddListener("a", func() {}).
+		Listeners("a")
+
+	if "" != infos[0].CallSite {
+		t.Error("Captured the call site before it was enabled.", infos[0].CallSite)
+	}
+
+	if !strings.Contains(infos[1].CallSite, "dump_test.go:") {
+		t.Error("Failed to capture the call site outside of the package.", infos[1].CallSite)
+	}
+}
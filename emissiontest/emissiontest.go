@@ -0,0 +1,125 @@
+// Package emissiontest provides a recording emitter and assertions for
+// testing code that emits events.
+package emissiontest
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/chuckpreslar/emission"
+)
+
+// Emission is an event and the arguments it was emitted with.
+type Emission struct {
+	// The event emitted.
+	Event interface{}
+	// Copy of the arguments the event was emitted with.
+	Arguments []interface{}
+}
+
+// Recorder is an Emitter recording every event emitted through it.
+type Recorder struct {
+	// The Emitter recorded.
+	*emission.Emitter
+
+	// Mutex guarding the emissions recorded.
+	mutex sync.Mutex
+	// Emissions recorded, in the order they occurred.
+	emissions []Emission
+}
+
+// NewRecorder returns a Recorder for a new Emitter.
+func NewRecorder() *Recorder {
+	return Record(emission.NewEmitter())
+}
+
+// Record returns a Recorder recording the events emitted through the
+// emitter from then on.
+func Record(emitter *emission.Emitter) *Recorder {
+	recorder := &Recorder{Emitter: emitter}
+	emitter.OnBeforeEmit(recorder.record)
+	return recorder
+}
+
+// record records the emission of the event with a copy of the arguments,
+// being called by the Emitter before each emission.
+func (recorder *Recorder) record(event interface{}, arguments []interface{}) {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	recorder.emissions = append(recorder.emissions, Emission{
+		Event:     event,
+		Arguments: append([]interface{}(nil), arguments...),
+	})
+}
+
+// Emissions returns the emissions recorded, in the order they occurred.
+func (recorder *Recorder) Emissions() []Emission {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	return append([]Emission(nil), recorder.emissions...)
+}
+
+// EmittedEvents returns the events recorded, in the order they were
+// emitted.
+func (recorder *Recorder) EmittedEvents() []interface{} {
+	emissions := recorder.Emissions()
+	events := make([]interface{}, len(emissions))
+
+	for i, recorded := range emissions {
+		events[i] = recorded.Event
+	}
+
+	return events
+}
+
+// Clear discards the emissions recorded so far.
+func (recorder *Recorder) Clear() {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	recorder.emissions = nil
+}
+
+// Emitted reports whether the event was emitted with the arguments. With no
+// arguments, any emission of the event matches.
+func (recorder *Recorder) Emitted(event interface{}, arguments ...interface{}) bool {
+	for _, recorded := range recorder.Emissions() {
+		if recorded.Event != event {
+			continue
+		}
+
+		if 0 == len(arguments) || reflect.DeepEqual(recorded.Arguments, arguments) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AssertEmitted reports an error to t unless the event was emitted with the
+// arguments, as determined by Emitted.
+func (recorder *Recorder) AssertEmitted(t testing.TB, event interface{}, arguments ...interface{}) bool {
+	t.Helper()
+
+	if !recorder.Emitted(event, arguments...) {
+		t.Errorf("Expected %v to be emitted with %v, emitted %v.", event, arguments, recorder.Emissions())
+		return false
+	}
+
+	return true
+}
+
+// AssertNotEmitted reports an error to t if the event was emitted.
+func (recorder *Recorder) AssertNotEmitted(t testing.TB, event interface{}) bool {
+	t.Helper()
+
+	if recorder.Emitted(event) {
+		t.Errorf("Expected %v not to be emitted, emitted %v.", event, recorder.Emissions())
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,31 @@
+package emissiontest
+
+import (
+	"testing"
+)
+
+func TestRecorder(t *testing.T) {
+	recorder := NewRecorder()
+
+	recorder.On("user.created", func(name string) {}).
+		Emit("user.created", "alice").
+		Emit("user.deleted", "bob")
+
+	recorder.AssertEmitted(t, "user.created")
+	recorder.AssertEmitted(t, "user.created", "alice")
+	recorder.AssertNotEmitted(t, "user.updated")
+
+	if recorder.Emitted("user.created", "bob") {
+		t.Error("Reported an emission with the wrong arguments.")
+	}
+
+	if events := recorder.EmittedEvents(); 2 != len(events) || "user.created" != events[0] || "user.deleted" != events[1] {
+		t.Error("Failed to record the emitted events.", events)
+	}
+
+	recorder.Clear()
+
+	if 0 != len(recorder.Emissions()) {
+		t.Error("Failed to clear the recorded emissions.")
+	}
+}
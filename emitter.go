@@ -2,11 +2,17 @@
 package emission
 
 import (
+	"context"
 	"errors"
-	"fmt"
-	"os"
+	"io"
+	"log/slog"
 	"reflect"
+	"regexp"
+	"runtime/debug"
+	"sort"
 	"sync"
+	"sync/atomic"
+	"time"
 )
 
 // Default number of maximum listeners for an event.
@@ -15,21 +21,314 @@ const DefaultMaxListeners = 10
 // Error presented when an invalid argument is provided as a listener function
 var ErrNoneFunction = errors.New("Kind of Value for listener is not Func.")
 
+// ErrNotAFunction is an alias of ErrNoneFunction.
+var ErrNotAFunction = ErrNoneFunction
+
+// Error returned by TryEmit when the arguments do not align with the
+// parameters of a listener.
+var ErrArgumentMismatch = errors.New("Arguments do not align with the parameters of a listener.")
+
+// Error presented when adding a listener would exceed the maximum number of
+// listeners of an event while the Emitter is strict.
+var ErrMaxListeners = errors.New("Event has reached the maximum number of listeners.")
+
+// Error returned by TryEmit when an event has no listeners to call.
+var ErrNoListeners = errors.New("Event has no listeners.")
+
+// Error presented when Times is asked to call a listener fewer than once.
+var ErrInvalidTimes = errors.New("Listener must be called at least once.")
+
+// Pool of slices reused to hold the argument values of listener calls.
+var valuesPool = sync.Pool{
+	New: func() interface{} {
+		return new([]reflect.Value)
+	},
+}
+
+// Any is the wildcard event. Listeners added for Any are called for every
+// event emitted, receiving the event as their first argument.
+const Any wildcard = "*"
+
+// wildcard is the type of the Any event, keeping it distinct from
+// user events of the same value.
+type wildcard string
+
+// Meta-events emitted synchronously by the Emitter when a listener is added
+// or removed, supplying listeners with the event and the listener function.
+const (
+	NewListenerEvent    metaEvent = "newListener"
+	RemoveListenerEvent metaEvent = "removeListener"
+)
+
+// metaEvent is the type of the Emitter's meta-events, keeping them
+// distinct from user events of the same value.
+type metaEvent string
+
 // RecoveryListener ...
 type RecoveryListener func(interface{}, interface{}, error)
 
+// UnhandledListener is called with the event and arguments of an emission
+// which found no listeners to call.
+type UnhandledListener func(interface{}, []interface{})
+
 // Emitter ...
 type Emitter struct {
-	// Mutex to prevent race conditions within the Emitter.
-	*sync.Mutex
-	// Map of event to a slice of listener function's reflect Values.
-	events map[interface{}][]reflect.Value
-	// Optional RecoveryListener to call when a panic occurs.
-	recoverer RecoveryListener
+	// Mutex to prevent race conditions within the Emitter, allowing
+	// concurrent emissions to read its state without contending.
+	sync.RWMutex
+	// Once used to initialize the Emitter on first use.
+	initOnce sync.Once
+	// Table of listeners, replaced rather than modified.
+	table atomic.Pointer[table]
+	// Optional RecoveryListener to call when a panic occurs, calling each
+	// of the Emitter's RecoveryListeners, read without taking the mutex.
+	recoverer atomic.Pointer[RecoveryListener]
+	// Identifier of the last emission.
+	emissions atomic.Uint64
+	// Map of event to the *atomic.Uint64 holding the sequence number of its
+	// last emission.
+	sequences sync.Map
+	// RecoveryListeners called when a panic occurs, in the order they
+	// were added.
+	recoverers []RecoveryListener
 	// Maximum listeners for debugging potential memory leaks.
 	maxListeners int
-	// Map used to remove Listeners wrapped in a Once func
-	onces map[reflect.Value]reflect.Value
+	// Middleware wrapping every emission, in the order they were added.
+	middleware []ContextMiddleware
+	// Hooks called before and after every emission.
+	beforeHooks, afterHooks []EmitHook
+	// Handle assigned to the most recently added listener.
+	handle Handle
+	// Map of handle to the listener it identifies.
+	handles map[Handle]*listenerRecord
+	// Map of event to the arguments of its latest sticky emission.
+	sticky map[interface{}][]interface{}
+	// Number of latest emissions recorded per event.
+	historySize int
+	// Map of event to the history of its latest emissions.
+	histories map[interface{}]*history
+	// Optional UnhandledListener to call when an event has no listeners.
+	unhandled UnhandledListener
+	// Number of listeners running within their own go routine.
+	inflight atomic.Int64
+	// Channel closed once no listeners are in flight, made by the first
+	// waiter and swapped out by the last listener to finish.
+	idle atomic.Pointer[chan struct{}]
+	// Whether the Emitter has been closed.
+	closed bool
+	// Whether the Emitter is paused, buffering emissions.
+	paused bool
+	// Emissions buffered while the Emitter is paused.
+	buffered []emission
+	// Optional Dispatcher scheduling calls of listeners.
+	dispatcher Dispatcher
+	// PoolDispatcher owned by the Emitter, if any.
+	pool *PoolDispatcher
+	// Optional event loop processing every emission.
+	loop *loop
+	// Number of calls each listener's mailbox holds, if enabled.
+	mailboxSize int
+	// Order in which Emit starts listeners.
+	ordering Ordering
+	// Map of event to the handler Call invokes for it.
+	handlers map[interface{}]*listenerRecord
+	// Number of panics within the breaker's window tripping a listener's
+	// circuit breaker, if enabled.
+	breakerThreshold int
+	// Window within which a listener's panics are counted.
+	breakerWindow time.Duration
+	// Optional RetryPolicy redelivering failed listener calls.
+	retry *RetryPolicy
+	// Observers notified of the Emitter's activity.
+	observers []Observer
+	// Optional logger the Emitter logs to.
+	logger *slog.Logger
+	// Optional WarningHandler called when an event exceeds the maximum
+	// number of listeners.
+	warningHandler WarningHandler
+	// Optional writer the maximum listeners warning is written to.
+	warningWriter io.Writer
+	// Whether adding a listener beyond the maximum fails rather than warns.
+	strict bool
+	// Map of event to the prototype of its listeners, if registered.
+	schemas map[interface{}]reflect.Type
+	// How arguments are marshaled into the parameters of listeners.
+	marshaling marshaling
+	// Duration beyond which a listener call is slow.
+	slowThreshold time.Duration
+	// Optional SlowListener called when a listener call is slow.
+	slow SlowListener
+	// Whether listeners called within their own go routine are labeled
+	// for the profiler.
+	labeled bool
+	// Emissions scheduled by ScheduleEmit and ScheduleEmitAt.
+	schedules []*schedule
+	// Optional scheduler emitting the scheduled events, if started.
+	scheduler *scheduler
+	// Map of event to its debouncer, if debounced.
+	debouncers map[interface{}]*debouncer
+	// Map of event to its throttler, if throttled.
+	throttlers map[interface{}]*throttler
+	// Map of coalesced event to the arguments of its emissions in flight.
+	coalesced map[interface{}][][]interface{}
+	// Window within which EmitDedup suppresses emissions sharing a key.
+	dedupWindow time.Duration
+	// Map of event and key to the time of its last emission by EmitDedup.
+	deduped map[dedupKey]time.Time
+	// Time the keys seen by EmitDedup were last swept.
+	dedupSwept time.Time
+	// Map of event to its sampler, if sampled.
+	samplers map[interface{}]*sampler
+	// Maximum number of emissions queued on the event loop, if non-zero.
+	queueLimit int
+	// How an emission is queued once the event loop's queue is full.
+	overflow OverflowPolicy
+	// Whether the call site adding each listener is captured.
+	callSites bool
+	// Maximum number of emissions an emission may be nested within, if
+	// non-zero.
+	maxDepth int
+}
+
+// listenerRecord is a listener function registered with the Emitter.
+type listenerRecord struct {
+	// Handle identifying the listener.
+	handle Handle
+	// Optional name describing the listener.
+	name string
+	// Tags the listener was added with.
+	tags []string
+	// File and line of the call adding the listener, if captured.
+	site string
+	// Time the listener was added.
+	added time.Time
+	// The event the listener was added for.
+	event interface{}
+	// The reflect Value of the listener function.
+	fn reflect.Value
+	// The listener function if it has the canonical signature, called
+	// directly rather than through the reflect package.
+	direct func(...interface{})
+	// The listener function if it accepts the Event envelope, called
+	// directly rather than through the reflect package.
+	envelope func(Event)
+	// The listener function if it accepts a batch of Event envelopes,
+	// called directly rather than through the reflect package.
+	batch func([]Event)
+	// Types of the listener function's parameters.
+	params []reflect.Type
+	// Number of the listener function's parameters ahead of its variadic
+	// parameter, if any, else of all its parameters.
+	fixed int
+	// Type of the elements of the listener function's variadic parameter,
+	// or nil if it is not variadic.
+	variadic reflect.Type
+	// Whether the listener function's first parameter is a context.Context.
+	takesContext bool
+	// Whether the emitted event is passed ahead of the arguments.
+	withEvent bool
+	// Priority of the listener, higher priorities being called first.
+	priority int
+	// Number of times the listener may be called before it is removed,
+	// or 0 if it is never removed.
+	times int
+	// Number of times the listener has been called, claimed by
+	// compare-and-swap so that it never exceeds times.
+	calls atomic.Int64
+	// Whether calls of the listener are skipped.
+	paused atomic.Bool
+	// Mailbox queuing calls of the listener, if any.
+	mailbox atomic.Pointer[mailbox]
+	// Queue ordering calls of the listener, if added with WithFIFO.
+	fifo *fifo
+	// Time after which the listener is removed, if any.
+	expires time.Time
+	// Timer removing the listener once it expires, if any.
+	timer *time.Timer
+	// Maximum duration Emit and EmitSync wait for a call of the listener,
+	// if any.
+	timeout time.Duration
+	// Predicate over the emitted arguments deciding whether the listener
+	// is called, if any.
+	filter func(...interface{}) bool
+	// Predicate over the emitted event deciding whether the listener is
+	// called, if any.
+	predicate func(interface{}) bool
+	// Times at which the listener panicked within the circuit breaker's
+	// window.
+	failures []time.Time
+	// Optional RecoveryListener called instead of the Emitter's when the
+	// listener panics.
+	recoverer RecoveryListener
+	// Cumulative duration of the listener's calls, in nanoseconds.
+	duration atomic.Int64
+	// Time the listener was last called, in nanoseconds since the epoch.
+	lastCalled atomic.Int64
+	// Number of calls of the listener which panicked.
+	panics atomic.Int64
+}
+
+// setFunc sets the listener function, caching the details of its type
+// needed to call it.
+func (l *listenerRecord) setFunc(fn reflect.Value) {
+	t := fn.Type()
+
+	l.fn = fn
+	l.direct, _ = fn.Interface().(func(...interface{}))
+	l.envelope, _ = fn.Interface().(func(Event))
+	l.batch, _ = fn.Interface().(func([]Event))
+	l.params = make([]reflect.Type, t.NumIn())
+
+	for i := range l.params {
+		l.params[i] = t.In(i)
+	}
+
+	l.fixed, l.variadic = len(l.params), nil
+
+	if t.IsVariadic() {
+		l.fixed--
+		l.variadic = l.params[l.fixed].Elem()
+	}
+
+	l.takesContext = 0 < len(l.params) && contextType == l.params[0]
+}
+
+// release stops the removed listener's expiry timer and closes its mailbox
+// and queue.
+func (l *listenerRecord) release() {
+	if nil != l.timer {
+		l.timer.Stop()
+	}
+
+	if m := l.mailbox.Load(); nil != m {
+		m.close()
+	}
+
+	if nil != l.fifo {
+		l.fifo.close()
+	}
+}
+
+// notify synchronously calls the meta-event's listeners for the listener
+// record, unless the record itself is a listener of a meta-event. Unlike
+// other events, meta-events bypass the Emitter's hooks and middleware.
+func (emitter *Emitter) notify(meta metaEvent, record *listenerRecord) {
+	if _, ok := record.event.(metaEvent); ok {
+		return
+	}
+
+	arguments := []interface{}{record.event, record.fn.Interface()}
+
+	for _, l := range emitter.listenersFor(meta) {
+		emitter.call(nil, meta, l, arguments)
+	}
+}
+
+// passesEvent reports whether listeners added for the event receive
+// the emitted event as their first argument.
+func passesEvent(event interface{}) bool {
+	_, ok := event.(*regexp.Regexp)
+	return ok || Any == event
 }
 
 // AddListener appends the listener argument to the event arguments slice
@@ -37,29 +336,149 @@ type Emitter struct {
 // is greater than the Emitter's maximum listeners then a warning is printed.
 // If the relect Value of the listener does not have a Kind of Func then
 // AddListener panics. If a RecoveryListener has been set then it is called
-// recovering from the panic.
+// recovering from the panic. Listeners whose first parameter is a
+// context.Context are passed the context of each emission ahead of the
+// arguments, derived from the context emitted with if any.
 func (emitter *Emitter) AddListener(event, listener interface{}) *Emitter {
+	emitter.addListener(event, listener, &listenerRecord{}, false)
+	return emitter
+}
+
+// TryAddListener adds the listener as AddListener does, but returns an
+// error rather than panicking or calling the RecoveryListener: ErrClosed if
+// the Emitter is closed, ErrNotAFunction if the listener is not a function,
+// or ErrMaxListeners if the event has reached the maximum number of
+// listeners, in which case the listener is not added.
+func (emitter *Emitter) TryAddListener(event, listener interface{}) error {
+	_, err := emitter.add(event, listener, &listenerRecord{}, false, true)
+	return err
+}
+
+// AddListenerWithPriority adds the listener as AddListener does, calling
+// listeners with a higher priority before those with a lower priority.
+// Listeners added with AddListener have a priority of 0, and listeners of
+// equal priority are called in the order they were added.
+func (emitter *Emitter) AddListenerWithPriority(event, listener interface{}, priority int) *Emitter {
+	emitter.addListener(event, listener, &listenerRecord{priority: priority}, false)
+	return emitter
+}
+
+// addListener adds the listener to the event's listeners as described by
+// AddListener, using the record to describe it and placing it ahead of the
+// listeners of the same priority if prepend is true. The record is
+// returned, or nil if the listener is invalid, in which case addListener
+// panics with the error or calls the RecoveryListener with it if one has
+// been set.
+func (emitter *Emitter) addListener(event, listener interface{}, record *listenerRecord, prepend bool) *listenerRecord {
+	record, err := emitter.add(event, listener, record, prepend, false)
+
+	if nil != err {
+		if recoverer := emitter.recovery(); nil == recoverer {
+			panic(err)
+		} else {
+			recoverer(event, listener, err)
+		}
+	}
+
+	return record
+}
+
+// add adds the listener as addListener does, returning the error if the
+// listener is invalid or the Emitter is closed, or ErrMaxListeners if the
+// event has reached the maximum number of listeners and either strict is
+// true or the Emitter is strict.
+func (emitter *Emitter) add(event, listener interface{}, record *listenerRecord, prepend, strict bool) (*listenerRecord, error) {
+	emitter.RLock()
+	captured := emitter.callSites
+	emitter.RUnlock()
+
+	if captured {
+		record.site = callSite()
+	}
+
+	record, count, err := emitter.insert(event, listener, record, prepend, strict)
+
+	if nil != err {
+		return nil, err
+	}
+
+	if 0 != count {
+		emitter.warn(event, count, record.displayName())
+	}
+
+	emitter.log(slog.LevelDebug, "listener added", "event", event, "handle", record.handle)
+	emitter.notify(NewListenerEvent, record)
+	emitter.replaySticky(record)
+	return record, nil
+}
+
+// insert validates the listener and inserts its record into the event's
+// listeners for add, returning the error if it fails. The event's number of
+// listeners is returned if it exceeds the maximum, else 0.
+func (emitter *Emitter) insert(event, listener interface{}, record *listenerRecord, prepend, strict bool) (*listenerRecord, int, error) {
+	emitter.lazyInit()
 	emitter.Lock()
 	defer emitter.Unlock()
 
 	fn := reflect.ValueOf(listener)
 
+	if emitter.closed {
+		return nil, 0, ErrClosed
+	}
+
 	if reflect.Func != fn.Kind() {
-		if nil == emitter.recoverer {
-			panic(ErrNoneFunction)
-		} else {
-			emitter.recoverer(event, listener, ErrNoneFunction)
+		return nil, 0, ErrNoneFunction
+	}
+
+	if err := checkEvent(event); nil != err {
+		return nil, 0, err
+	}
+
+	listeners := emitter.load().events[event]
+	count := 0
+
+	if emitter.maxListeners != -1 && emitter.maxListeners < len(listeners)+1 {
+		if strict || emitter.strict {
+			return nil, 0, ErrMaxListeners
 		}
+
+		count = len(listeners) + 1
 	}
 
-	if emitter.maxListeners != -1 && emitter.maxListeners < len(emitter.events[event])+1 {
-		fmt.Fprintf(os.Stdout, "Warning: event `%v` has exceeded the maximum "+
-			"number of listeners of %d.\n", event, emitter.maxListeners)
+	record.setFunc(fn)
+	record.withEvent = record.withEvent || passesEvent(event)
+
+	if prototype, ok := emitter.schemas[event]; ok && !matches(prototype, record) {
+		return nil, 0, ErrSignatureMismatch
 	}
 
-	emitter.events[event] = append(emitter.events[event], fn)
+	emitter.handle++
 
-	return emitter
+	record.handle = emitter.handle
+	emitter.handles[record.handle] = record
+	record.event = event
+	record.added = time.Now()
+
+	// Insert the record after every listener with a higher priority,
+	// and after those of an equal priority unless prepending.
+	i := sort.Search(len(listeners), func(i int) bool {
+		if prepend {
+			return listeners[i].priority <= record.priority
+		}
+
+		return listeners[i].priority < record.priority
+	})
+
+	newEvents := make([]*listenerRecord, 0, len(listeners)+1)
+	newEvents = append(newEvents, listeners[:i]...)
+	newEvents = append(newEvents, record)
+	newEvents = append(newEvents, listeners[i:]...)
+
+	emitter.modify(func(t *table) {
+		t.events[event] = newEvents
+	})
+
+	return record, count, nil
 }
 
 // On is an alias for AddListener.
@@ -72,36 +491,126 @@ func (emitter *Emitter) On(event, listener interface{}) *Emitter {
 // have a Kind of Func then RemoveListener panics. If a RecoveryListener has
 // been set then it is called after recovering from the panic.
 func (emitter *Emitter) RemoveListener(event, listener interface{}) *Emitter {
-	emitter.Lock()
-	defer emitter.Unlock()
-
 	fn := reflect.ValueOf(listener)
 
 	if reflect.Func != fn.Kind() {
-		if nil == emitter.recoverer {
+		if recoverer := emitter.recovery(); nil == recoverer {
 			panic(ErrNoneFunction)
 		} else {
-			emitter.recoverer(event, listener, ErrNoneFunction)
+			recoverer(event, listener, ErrNoneFunction)
+			return emitter
 		}
 	}
 
-	if events, ok := emitter.events[event]; ok {
-		if _, ok = emitter.onces[fn]; ok {
-			fn = emitter.onces[fn]
-		}
+	if emitter.rejectEvent(event) {
+		return emitter
+	}
+
+	emitter.removeListeners(event, func(l *listenerRecord) bool {
+		return fn.Pointer() == l.fn.Pointer()
+	})
+
+	return emitter
+}
+
+// RemoveAllListeners removes every listener of the event from the Emitter's
+// events map, emitting the RemoveListenerEvent for each.
+func (emitter *Emitter) RemoveAllListeners(event interface{}) *Emitter {
+	if emitter.rejectEvent(event) {
+		return emitter
+	}
+
+	emitter.removeListeners(event, func(*listenerRecord) bool {
+		return true
+	})
+
+	return emitter
+}
+
+// Reset removes every listener of every event from the Emitter, including
+// those added with OnMatch. No meta-events are emitted.
+func (emitter *Emitter) Reset() *Emitter {
+	emitter.Lock()
+	defer releaseAll(emitter.handles)
+	defer emitter.Unlock()
+
+	emitter.modify(func(t *table) {
+		t.events = make(map[interface{}][]*listenerRecord)
+		t.patterns = nil
+	})
+
+	emitter.forgetSequences()
+
+	emitter.handles = make(map[Handle]*listenerRecord)
+	return emitter
+}
+
+// releaseAll releases the removed listeners, called once the Emitter's mutex
+// has been released as their calls may be blocked posting to their mailbox.
+func releaseAll(handles map[Handle]*listenerRecord) {
+	for _, record := range handles {
+		record.release()
+	}
+}
 
-		newEvents := []reflect.Value{}
+// removeRecord removes the listener record from its event's listeners,
+// reporting whether it was found.
+func (emitter *Emitter) removeRecord(record *listenerRecord) bool {
+	removed := emitter.removeListeners(record.event, func(l *listenerRecord) bool {
+		return record == l
+	})
 
-		for _, listener := range events {
-			if fn.Pointer() != listener.Pointer() {
+	return 0 != len(removed)
+}
+
+// removeListeners removes the event's listeners for which matches returns
+// true, emitting the RemoveListenerEvent for each and returning them.
+func (emitter *Emitter) removeListeners(event interface{}, matches func(*listenerRecord) bool) (removed []*listenerRecord) {
+	emitter.Lock()
+
+	if listeners, ok := emitter.load().events[event]; ok {
+		newEvents := []*listenerRecord{}
+
+		for _, listener := range listeners {
+			if matches(listener) {
+				removed = append(removed, listener)
+			} else {
 				newEvents = append(newEvents, listener)
 			}
 		}
 
-		emitter.events[event] = newEvents
+		emitter.modify(func(t *table) {
+			if 0 == len(newEvents) {
+				delete(t.events, event)
+				t.removePattern(event)
+			} else {
+				t.events[event] = newEvents
+			}
+		})
+
+		if 0 == len(newEvents) {
+			emitter.forgetSequences(event)
+		}
 	}
 
-	return emitter
+	for _, record := range removed {
+		delete(emitter.handles, record.handle)
+	}
+
+	emitter.Unlock()
+
+	// Removed listeners are released without holding the Emitter's mutex, as
+	// their calls may be blocked posting to their mailbox.
+	for _, record := range removed {
+		record.release()
+	}
+
+	for _, record := range removed {
+		emitter.log(slog.LevelDebug, "listener removed", "event", event, "handle", record.handle)
+		emitter.notify(RemoveListenerEvent, record)
+	}
+
+	return
 }
 
 // Off is an alias for RemoveListener.
@@ -109,42 +618,91 @@ func (emitter *Emitter) Off(event, listener interface{}) *Emitter {
 	return emitter.RemoveListener(event, listener)
 }
 
-// Once generates a new function which invokes the supplied listener
-// only once before removing itself from the event's listener slice
-// in the Emitter's events map. If the reflect Value of the listener
-// does not have a Kind of Func then Once panics. If a RecoveryListener
-// has been set then it is called after recovering from the panic.
+// Once adds the listener as AddListener does, removing it from the event's
+// listener slice in the Emitter's events map before it is first invoked.
+// If the reflect Value of the listener does not have a Kind of Func then
+// Once panics. If a RecoveryListener has been set then it is called after
+// recovering from the panic.
 func (emitter *Emitter) Once(event, listener interface{}) *Emitter {
-	fn := reflect.ValueOf(listener)
+	emitter.addListener(event, listener, &listenerRecord{times: 1}, false)
+	return emitter
+}
 
-	if reflect.Func != fn.Kind() {
-		if nil == emitter.recoverer {
-			panic(ErrNoneFunction)
+// Times adds the listener as AddListener does, removing it from the event's
+// listener slice in the Emitter's events map before it is invoked for the
+// n-th time. If n is less than 1 then Times panics with ErrInvalidTimes. If
+// a RecoveryListener has been set then it is called after recovering from
+// the panic.
+func (emitter *Emitter) Times(event interface{}, n int, listener interface{}) *Emitter {
+	if 1 > n {
+		if recoverer := emitter.recovery(); nil == recoverer {
+			panic(ErrInvalidTimes)
 		} else {
-			emitter.recoverer(event, listener, ErrNoneFunction)
+			recoverer(event, listener, ErrInvalidTimes)
+			return emitter
 		}
 	}
 
-	var run func(...interface{})
+	emitter.addListener(event, listener, &listenerRecord{times: n}, false)
+	return emitter
+}
+
+// OnFiltered adds the listener as AddListener does, but only calls it when
+// the filter returns true for the emitted arguments.
+func (emitter *Emitter) OnFiltered(event interface{}, filter func(...interface{}) bool, listener interface{}) *Emitter {
+	emitter.addListener(event, listener, &listenerRecord{filter: filter}, false)
+	return emitter
+}
 
-	run = func(arguments ...interface{}) {
-		defer emitter.RemoveListener(event, run)
+// OnceWhen adds the listener as Once does, but only calls and removes it
+// once the predicate returns true for the emitted arguments. Until then the
+// listener remains in the event's listener slice.
+func (emitter *Emitter) OnceWhen(event interface{}, predicate func(...interface{}) bool, listener interface{}) *Emitter {
+	emitter.addListener(event, listener, &listenerRecord{
+		times:  1,
+		filter: predicate,
+	}, false)
 
-		var values []reflect.Value
+	return emitter
+}
 
-		for i := 0; i < len(arguments); i++ {
-			values = append(values, reflect.ValueOf(arguments[i]))
-		}
+// OnWithTTL adds the listener as AddListener does, removing it from the
+// event's listener slice in the Emitter's events map once the ttl has
+// elapsed.
+func (emitter *Emitter) OnWithTTL(event, listener interface{}, ttl time.Duration) *Emitter {
+	record := emitter.addListener(event, listener, &listenerRecord{
+		expires: time.Now().Add(ttl),
+	}, false)
 
-		fn.Call(values)
+	if nil != record {
+		emitter.Lock()
+		record.timer = time.AfterFunc(ttl, func() {
+			emitter.removeRecord(record)
+		})
+		emitter.Unlock()
 	}
 
-	// Lock before changing onces
-	emitter.Lock()
-	emitter.onces[fn] = reflect.ValueOf(run)
-	emitter.Unlock()
+	return emitter
+}
 
-	emitter.AddListener(event, run)
+// OnWithRecovery adds the listener as AddListener does, recovering from its
+// panics with the RecoveryListener instead of the Emitter's.
+func (emitter *Emitter) OnWithRecovery(event, listener interface{}, recoverer RecoveryListener) *Emitter {
+	emitter.addListener(event, listener, &listenerRecord{recoverer: recoverer}, false)
+	return emitter
+}
+
+// PrependListener adds the listener as AddListener does, but ahead of the
+// event's other listeners of the same priority instead of after them.
+func (emitter *Emitter) PrependListener(event, listener interface{}) *Emitter {
+	emitter.addListener(event, listener, &listenerRecord{}, true)
+	return emitter
+}
+
+// PrependOnceListener adds the listener as Once does, but ahead of the
+// event's other listeners of the same priority instead of after them.
+func (emitter *Emitter) PrependOnceListener(event, listener interface{}) *Emitter {
+	emitter.addListener(event, listener, &listenerRecord{times: 1}, true)
 	return emitter
 }
 
@@ -155,126 +713,565 @@ func (emitter *Emitter) Once(event, listener interface{}) *Emitter {
 // If a RecoveryListener has been set then it is called after recovering from
 // the panic.
 func (emitter *Emitter) Emit(event interface{}, arguments ...interface{}) *Emitter {
-	var (
-		listeners []reflect.Value
-		ok        bool
-	)
+	emitter.emit(nil, Event{Name: event, Args: arguments}, emitter.parallel)
+	return emitter
+}
 
-	// Lock the mutex when reading from the Emitter's
-	// events map.
-	emitter.Lock()
+// EmitSync attempts to use the reflect package to Call each listener stored
+// in the Emitter's events map with the supplied arguments. Each listener
+// is called synchronously. The reflect package will panic if
+// the agruments supplied do not align the parameters of a listener function.
+// If a RecoveryListener has been set then it is called after recovering from
+// the panic.
+func (emitter *Emitter) EmitSync(event interface{}, arguments ...interface{}) *Emitter {
+	emitter.emit(nil, Event{Name: event, Args: arguments}, emitter.serial)
+	return emitter
+}
 
-	if listeners, ok = emitter.events[event]; !ok {
-		// If the Emitter does not include the event in its
-		// event map, it has no listeners to Call yet.
-		emitter.Unlock()
-		return emitter
+// TryEmit emits the event as Emit does, returning ErrNoListeners if the
+// event has no listeners to call, or ErrClosed if the Emitter is closed.
+// Nothing is emitted and ErrArgumentMismatch is returned if the arguments
+// do not align with the parameters of one of the event's listeners, or
+// ErrInvalidEvent if the event cannot be used as a key. An emission which
+// is deferred, such as while the Emitter is paused, is not reported as
+// having no listeners.
+func (emitter *Emitter) TryEmit(event interface{}, arguments ...interface{}) error {
+	if emitter.isClosed() {
+		return ErrClosed
 	}
 
-	// Unlock the mutex immediately following the read
-	// instead of deferring so that listeners registered
-	// with Once can aquire the mutex for removal.
-	emitter.Unlock()
+	if err := emitter.validate(event, arguments); nil != err {
+		return err
+	}
+
+	marshaling := emitter.load().marshaling
+
+	for _, l := range emitter.listenersFor(event) {
+		if !l.accepts(event, arguments, marshaling) {
+			return ErrArgumentMismatch
+		}
+	}
+
+	if count, deferred := emitter.emit(nil, Event{Name: event, Args: arguments}, emitter.parallel); 0 == count && !deferred {
+		return ErrNoListeners
+	}
+
+	return nil
+}
+
+// EmitCount emits the event as Emit does, returning the number of
+// listeners dispatched. If the emission is deferred, such as while the
+// Emitter is paused, the number of listeners it would dispatch if delivered
+// now is returned.
+func (emitter *Emitter) EmitCount(event interface{}, arguments ...interface{}) int {
+	count, deferred := emitter.emit(nil, Event{Name: event, Args: arguments}, emitter.parallel)
+
+	if deferred {
+		return len(emitter.listenersFor(event))
+	}
+
+	return count
+}
+
+// parallel calls each listener within its own go routine, started in the
+// Emitter's Ordering, waiting for them all to return unless mailboxes are
+// enabled. Calls of listeners added with WithFIFO are queued rather than
+// waited for.
+func (emitter *Emitter) parallel(ctx context.Context, event interface{}, listeners []*listenerRecord, arguments []interface{}) {
+	t := emitter.load()
+	mailboxes, ordering := 0 < t.mailboxSize, t.ordering
+
+	if mailboxes {
+		// Waiting would deadlock a listener emitting an event it
+		// listens to, its call being queued behind its own.
+		emitter.async(ctx, event, listeners, arguments)
+		return
+	}
 
 	var wg sync.WaitGroup
 
-	wg.Add(len(listeners))
+	for _, l := range listeners {
+		l := l
 
-	for _, fn := range listeners {
-		go func(fn reflect.Value) {
-			defer wg.Done()
+		if nil != l.fifo {
+			// Waiting would deadlock a listener emitting an event it
+			// listens to, as with mailboxes.
+			emitter.spawn(event, l, func() {
+				emitter.timed(ctx, event, l, arguments)
+			})
 
-			// Recover from potential panics, supplying them to a
-			// RecoveryListener if one has been set, else allowing
-			// the panic to occur.
-			if nil != emitter.recoverer {
-				defer func() {
-					if r := recover(); nil != r {
-						err := fmt.Errorf("%v", r)
-						emitter.recoverer(event, fn.Interface(), err)
-					}
-				}()
-			}
+			continue
+		}
 
-			var values []reflect.Value
+		wg.Add(1)
 
-			for i := 0; i < len(arguments); i++ {
-				if arguments[i] == nil {
-					values = append(values, reflect.New(fn.Type().In(i)).Elem())
-				} else {
-					values = append(values, reflect.ValueOf(arguments[i]))
-				}
+		var step chan struct{}
+
+		if Unordered != ordering {
+			step = make(chan struct{})
+		}
+
+		emitter.spawn(event, l, func() {
+			defer wg.Done()
+
+			switch ordering {
+			case Ordered:
+				close(step)
+			case Serialized:
+				defer close(step)
 			}
 
-			fn.Call(values)
-		}(fn)
+			emitter.timed(ctx, event, l, arguments)
+		})
+
+		if Unordered != ordering {
+			<-step
+		}
 	}
 
 	wg.Wait()
-	return emitter
 }
 
-// EmitSync attempts to use the reflect package to Call each listener stored
-// in the Emitter's events map with the supplied arguments. Each listener
-// is called synchronously. The reflect package will panic if
-// the agruments supplied do not align the parameters of a listener function.
-// If a RecoveryListener has been set then it is called after recovering from
-// the panic.
-func (emitter *Emitter) EmitSync(event interface{}, arguments ...interface{}) *Emitter {
-	var (
-		listeners []reflect.Value
-		ok        bool
-	)
+// serial calls each listener synchronously, in order.
+func (emitter *Emitter) serial(ctx context.Context, event interface{}, listeners []*listenerRecord, arguments []interface{}) {
+	for _, l := range listeners {
+		emitter.timed(ctx, event, l, arguments)
+	}
+}
 
-	// Lock the mutex when reading from the Emitter's
-	// events map.
-	emitter.Lock()
+// dispatchFunc calls the listeners of an emission with its arguments.
+type dispatchFunc func(ctx context.Context, event interface{}, listeners []*listenerRecord, arguments []interface{})
 
-	if listeners, ok = emitter.events[event]; !ok {
-		// If the Emitter does not include the event in its
-		// event map, it has no listeners to Call yet.
-		emitter.Unlock()
-		return emitter
+// emit delivers the Event's Name and Args to dispatch, returning the number
+// of listeners dispatched and whether the emission was deferred. The
+// emission is admitted as admit does. A debounced or throttled emission is
+// held back and a coalesced emission is merged into an identical one in
+// flight, in which case it is deferred, else it is released.
+func (emitter *Emitter) emit(ctx context.Context, e Event, dispatch dispatchFunc) (int, bool) {
+	ctx, ok := emitter.admit(ctx, e)
+
+	if !ok {
+		return 0, false
 	}
 
-	// Unlock the mutex immediately following the read
-	// instead of deferring so that listeners registered
-	// with Once can aquire the mutex for removal.
-	emitter.Unlock()
+	event, arguments := e.Name, e.Args
 
-	for _, fn := range listeners {
-		// Recover from potential panics, supplying them to a
-		// RecoveryListener if one has been set, else allowing
-		// the panic to occur.
-		if nil != emitter.recoverer {
-			defer func() {
-				if r := recover(); nil != r {
-					err := fmt.Errorf("%v", r)
-					emitter.recoverer(event, fn.Interface(), err)
-				}
-			}()
+	if t := emitter.load(); t.debouncing || t.throttling {
+		pending := emission{ctx, event, arguments, dispatch}
+
+		if emitter.debounce(pending) || emitter.throttle(pending) {
+			return 0, true
 		}
+	}
 
-		var values []reflect.Value
+	done, merged := emitter.coalesce(event, arguments)
 
-		for i := 0; i < len(arguments); i++ {
-			if arguments[i] == nil {
-				values = append(values, reflect.New(fn.Type().In(i)).Elem())
-			} else {
-				values = append(values, reflect.ValueOf(arguments[i]))
+	if merged {
+		discard(ctx)
+		return 0, true
+	}
+
+	if nil != done {
+		defer done()
+	}
+
+	return emitter.release(ctx, event, arguments, dispatch)
+}
+
+// emitNow delivers the Event's Name and Args to dispatch as emit does, but
+// immediately: the emission is never debounced, throttled, coalesced,
+// buffered while the Emitter is paused or queued on its event loop, so that
+// dispatch has returned once emitNow does. Emissions returning the results
+// of their listeners to the caller use it.
+func (emitter *Emitter) emitNow(ctx context.Context, e Event, dispatch dispatchFunc) int {
+	ctx, ok := emitter.admit(ctx, e)
+
+	if !ok || emitter.isClosed() {
+		return 0
+	}
+
+	return emitter.deliver(ctx, e.Name, e.Args, dispatch)
+}
+
+// admit validates and samples the emission of the Event, returning the
+// context supplied to dispatch, derived from ctx if non-nil and stamped with
+// the Event if stamps reports it must be, or false if the emission is
+// dropped. If the event cannot be used as a key, the arguments do not align
+// with the event's registered prototype or the emission is nested too deep,
+// admit panics with ErrInvalidEvent, ErrArgumentMismatch or ErrMaxDepth, or
+// calls the RecoveryListener with it.
+func (emitter *Emitter) admit(ctx context.Context, e Event) (context.Context, bool) {
+	if err := emitter.validate(e.Name, e.Args); nil != err {
+		if recoverer := emitter.recovery(); nil == recoverer {
+			panic(err)
+		} else {
+			recoverer(e.Name, nil, err)
+			return nil, false
+		}
+	}
+
+	if !emitter.sample(e.Name) {
+		return nil, false
+	}
+
+	if emitter.stamps(e) {
+		ctx = emitter.stamp(ctx, e)
+	} else if nil == ctx {
+		ctx = context.Background()
+	}
+
+	if err := emitter.checkDepth(ctx); nil != err {
+		if recoverer := emitter.recovery(); nil == recoverer {
+			panic(err)
+		} else {
+			recoverer(e.Name, nil, err)
+			return nil, false
+		}
+	}
+
+	return ctx, true
+}
+
+// release delivers the stamped emission to dispatch, returning the number
+// of listeners dispatched and whether the emission was deferred. Nothing is
+// emitted once the Emitter is closed, and the emission is deferred, being
+// buffered while the Emitter is paused or queued if the Emitter's event
+// loop is enabled.
+func (emitter *Emitter) release(ctx context.Context, event interface{}, arguments []interface{}, dispatch dispatchFunc) (int, bool) {
+	if emitter.isClosed() {
+		discard(ctx)
+		return 0, false
+	}
+
+	if emitter.buffer(ctx, event, arguments, dispatch) || emitter.enqueue(ctx, event, arguments, dispatch) {
+		return 0, true
+	}
+
+	return emitter.deliver(ctx, event, arguments, dispatch), false
+}
+
+// deliver passes the event and arguments through the Emitter's middleware,
+// then supplies the listeners to call and the resulting arguments to
+// dispatch, which is responsible for calling them, as deliverFrom does with
+// the Emitter's current table.
+func (emitter *Emitter) deliver(ctx context.Context, event interface{}, arguments []interface{}, dispatch dispatchFunc) int {
+	t := emitter.load()
+	return emitter.deliverFrom(ctx, t, event, arguments, t.listenersFor(event), dispatch)
+}
+
+// deliverFrom passes the event and arguments through the middleware of the
+// table, then supplies the listeners and the resulting arguments to
+// dispatch, which is responsible for calling them, or to the table's
+// UnhandledListener if there are none and one is set. The table's hooks are
+// called before and after, and the emission is recorded if the Emitter
+// keeps a history. The number of listeners dispatched is returned, the
+// emission being discarded if there are none.
+func (emitter *Emitter) deliverFrom(ctx context.Context, t *table, event interface{}, arguments []interface{}, listeners []*listenerRecord, dispatch dispatchFunc) (count int) {
+	emitter.recordHistory(event, arguments)
+
+	emitter.log(slog.LevelDebug, "event emitted", "event", event)
+
+	for _, observer := range t.observers {
+		observer.Emitted(event)
+	}
+
+	for _, hook := range t.beforeHooks {
+		hook(event, arguments)
+	}
+
+	if 0 == len(t.middleware) {
+		count = t.dispatch(ctx, event, listeners, arguments, dispatch)
+	} else {
+		var n int
+
+		t.intercept(ctx, event, arguments, func(arguments []interface{}) {
+			n = t.dispatch(ctx, event, listeners, arguments, dispatch)
+		})
+
+		count = n
+	}
+
+	for _, hook := range t.afterHooks {
+		hook(event, arguments)
+	}
+
+	if 0 == count {
+		discard(ctx)
+	}
+
+	return
+}
+
+// dispatch supplies the listeners and arguments to dispatch, or to the
+// table's UnhandledListener if there are no listeners and one is set,
+// returning the number of listeners dispatched.
+func (t *table) dispatch(ctx context.Context, event interface{}, listeners []*listenerRecord, arguments []interface{}, dispatch dispatchFunc) int {
+	if 0 == len(listeners) && nil != t.unhandled {
+		t.unhandled(event, arguments)
+		return 0
+	}
+
+	dispatch(ctx, event, listeners, arguments)
+	return len(listeners)
+}
+
+// listenersFor returns the listeners to call when the event is emitted,
+// read without taking the Emitter's mutex. The slice returned must not be
+// modified.
+func (emitter *Emitter) listenersFor(event interface{}) []*listenerRecord {
+	return emitter.load().listenersFor(event)
+}
+
+// claim counts a call of the listener, removing it if it has reached the
+// number of times it may be called. It reports false if the listener has
+// already been called that many times, has expired or is paused. Calls are
+// claimed atomically, so a listener added with Once is called at most once
+// however many emissions race to call it.
+func (emitter *Emitter) claim(l *listenerRecord) bool {
+	if !l.expires.IsZero() && time.Now().After(l.expires) {
+		emitter.removeRecord(l)
+		return false
+	}
+
+	if l.paused.Load() {
+		return false
+	}
+
+	times := int64(l.times)
+
+	for {
+		calls := l.calls.Load()
+
+		if 0 != times && calls >= times {
+			return false
+		}
+
+		if !l.calls.CompareAndSwap(calls, calls+1) {
+			continue
+		}
+
+		if 0 == times || calls+1 < times {
+			return true
+		}
+
+		return emitter.removeRecord(l)
+	}
+}
+
+// call invokes the listener function with the supplied arguments, as invoke
+// does. Listeners with a filter or predicate are only invoked if it accepts
+// the arguments or event respectively. Listeners added with Once or Times
+// are removed before their last invocation, and are not invoked once
+// removed. The values returned by the listener are returned along with
+// whether it was invoked.
+func (emitter *Emitter) call(ctx context.Context, event interface{}, l *listenerRecord, arguments []interface{}) (results []reflect.Value, called bool) {
+	if nil != l.filter && !l.filter(arguments...) {
+		return
+	}
+
+	if nil != l.predicate && !l.predicate(event) {
+		return
+	}
+
+	if !emitter.claim(l) {
+		return
+	}
+
+	return emitter.invoke(ctx, event, l, arguments, 1), true
+}
+
+// invoke calls the listener function with the supplied arguments, preceded
+// by the event if the listener was added for it and by a context if the
+// listener accepts a context.Context, as contextual prepends them. Listeners
+// with the canonical func(...interface{}) signature are called directly, as
+// are listeners accepting the Event envelope with the envelope of the
+// emission and listeners accepting a batch of envelopes with the batch of
+// the emission, else the arguments are marshaled into values by marshal as
+// the Emitter marshals them. A failed attempt is redelivered if the Emitter
+// has a RetryPolicy. If a RecoveryListener has been set for the listener or
+// else the Emitter then a panic raised by the listener is recovered from and
+// supplied to it as a *PanicError, else the panic is allowed to occur. The
+// values returned by the listener are returned when it is called through the
+// reflect package.
+func (emitter *Emitter) invoke(ctx context.Context, event interface{}, l *listenerRecord, arguments []interface{}, attempt int) (results []reflect.Value) {
+	fn := l.fn
+	original := arguments
+
+	arguments = l.contextual(ctx, event, arguments)
+
+	t := emitter.load()
+	tracked, retry, observers := 0 < t.breakerThreshold, t.retry, t.observers
+	threshold, slow, marshaling := t.slowThreshold, t.slow, t.marshaling
+
+	recoverer := emitter.recovery()
+
+	if nil != l.recoverer {
+		recoverer = l.recoverer
+	}
+
+	start := time.Now()
+	panicked := true
+
+	defer func() {
+		duration := time.Since(start)
+		l.track(start, duration, panicked)
+
+		if nil != slow && duration > threshold {
+			slow(event, l.handle, duration)
+		}
+	}()
+
+	if 0 != len(observers) {
+		defer func() {
+			for _, observer := range observers {
+				observer.Called(event, time.Since(start))
 			}
+		}()
+	}
+
+	if nil != recoverer || tracked || nil != retry || 0 != len(observers) {
+		defer func() {
+			if r := recover(); nil != r {
+				emitter.log(slog.LevelError, "listener panicked", "event", event, "handle", l.handle, "listener", l.displayName(), "panic", r)
+
+				for _, observer := range observers {
+					observer.Panicked(event)
+				}
+
+				if tracked {
+					emitter.fail(l)
+				}
+
+				err := &PanicError{Value: r, Listener: l.displayName(), Stack: debug.Stack()}
+
+				// Panics are only redelivered if their last attempt is handled.
+				if nil != retry && (nil != recoverer || nil != retry.DeadLetter) && emitter.redeliver(retry, ctx, event, l, original, attempt, err) {
+					return
+				}
+
+				if nil == recoverer {
+					panic(r)
+				}
+
+				recoverer(event, fn.Interface(), err)
+			}
+		}()
+	}
+
+	if nil != l.direct {
+		l.direct(arguments...)
+		panicked = false
+		return
+	}
+
+	if nil != l.envelope {
+		l.envelope(envelope(ctx, event, original))
+		panicked = false
+		return
+	}
+
+	if nil != l.batch {
+		l.batch(batchOf(ctx, event, original))
+		panicked = false
+		return
+	}
+
+	// Reuse a pooled slice for the argument values, clearing it before
+	// returning it so that the pool does not retain the arguments.
+	values := valuesPool.Get().(*[]reflect.Value)
+
+	defer func() {
+		for i := range *values {
+			(*values)[i] = reflect.Value{}
 		}
 
-		fn.Call(values)
+		*values = (*values)[:0]
+		valuesPool.Put(values)
+	}()
+
+	*values = l.marshal(*values, arguments, marshaling)
+
+	results = fn.Call(*values)
+	panicked = false
+
+	if err := listenerError(results); nil != retry && nil != err {
+		emitter.redeliver(retry, ctx, event, l, original, attempt, err)
 	}
 
-	return emitter
+	return
 }
 
 // RecoverWith sets the listener to call when a panic occurs, recovering from
-// panics and attempting to keep the application from crashing.
+// panics and attempting to keep the application from crashing. It replaces
+// any RecoveryListener previously set or added, and may be called while
+// events are being emitted.
 func (emitter *Emitter) RecoverWith(listener RecoveryListener) *Emitter {
-	emitter.recoverer = listener
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	emitter.recoverers = nil
+
+	if nil != listener {
+		emitter.recoverers = append(emitter.recoverers, listener)
+	}
+
+	emitter.storeRecoverer()
+	return emitter
+}
+
+// AddRecoveryListener adds the listener to call when a panic occurs, as
+// RecoverWith sets it, after the RecoveryListeners already set or added.
+func (emitter *Emitter) AddRecoveryListener(listener RecoveryListener) *Emitter {
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	if nil != listener {
+		emitter.recoverers = append(emitter.recoverers, listener)
+	}
+
+	emitter.storeRecoverer()
+	return emitter
+}
+
+// storeRecoverer stores the RecoveryListener calling each of the Emitter's
+// RecoveryListeners. The Emitter's mutex must be held.
+func (emitter *Emitter) storeRecoverer() {
+	recoverers := emitter.recoverers
+
+	switch len(recoverers) {
+	case 0:
+		emitter.recoverer.Store(nil)
+	case 1:
+		emitter.recoverer.Store(&recoverers[0])
+	default:
+		var recoverer RecoveryListener = func(event, listener interface{}, err error) {
+			for _, recoverer := range recoverers {
+				recoverer(event, listener, err)
+			}
+		}
+
+		emitter.recoverer.Store(&recoverer)
+	}
+}
+
+// recovery returns the RecoveryListener to call when a panic occurs, or nil
+// if none has been set.
+func (emitter *Emitter) recovery() RecoveryListener {
+	if recoverer := emitter.recoverer.Load(); nil != recoverer {
+		return *recoverer
+	}
+
+	return nil
+}
+
+// OnUnhandled sets the listener to call when an event is emitted without
+// any listeners to call, so that such emissions do not silently vanish.
+func (emitter *Emitter) OnUnhandled(listener UnhandledListener) *Emitter {
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	emitter.unhandled = listener
+	emitter.publish()
 	return emitter
 }
 
@@ -284,6 +1281,7 @@ func (emitter *Emitter) RecoverWith(listener RecoveryListener) *Emitter {
 // event can have a maximum number of 10 listeners which is
 // useful for finding memory leaks.
 func (emitter *Emitter) SetMaxListeners(max int) *Emitter {
+	emitter.lazyInit()
 	emitter.Lock()
 	defer emitter.Unlock()
 
@@ -291,24 +1289,93 @@ func (emitter *Emitter) SetMaxListeners(max int) *Emitter {
 	return emitter
 }
 
+// SetStrictMaxListeners makes adding a listener to an event which has
+// reached the maximum number of listeners fail, rather than warn and add
+// the listener. The listener is then not added, and AddListener panics with
+// ErrMaxListeners or calls the RecoveryListener with it if one has been set.
+func (emitter *Emitter) SetStrictMaxListeners(strict bool) *Emitter {
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	emitter.strict = strict
+	return emitter
+}
+
 // GetListenerCount gets count of listeners for a given event.
 func (emitter *Emitter) GetListenerCount(event interface{}) (count int) {
-	emitter.Lock()
-	if listeners, ok := emitter.events[event]; ok {
-		count = len(listeners)
+	if emitter.rejectEvent(event) {
+		return 0
 	}
-	emitter.Unlock()
+
+	count = len(emitter.load().events[event])
 	return
 }
 
+// HasListeners reports whether emitting the event would call any listener,
+// including those of its ancestors, of the patterns it matches and those
+// added for the Any event.
+func (emitter *Emitter) HasListeners(event interface{}) bool {
+	if emitter.rejectEvent(event) {
+		return false
+	}
+
+	t := emitter.load()
+
+	if 0 != len(t.events[event]) {
+		return true
+	}
+
+	if _, ok := event.(metaEvent); !ok && 0 != len(t.events[Any]) {
+		return true
+	}
+
+	for _, ancestor := range t.ancestors(event) {
+		if 0 != len(t.events[ancestor]) {
+			return true
+		}
+	}
+
+	for _, pattern := range t.matches(event) {
+		if 0 != len(t.events[pattern]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// EventNames returns the events which currently have at least one
+// listener, in no particular order.
+func (emitter *Emitter) EventNames() []interface{} {
+	events := []interface{}{}
+
+	for event, listeners := range emitter.load().events {
+		if 0 != len(listeners) {
+			events = append(events, event)
+		}
+	}
+
+	return events
+}
+
 // NewEmitter returns a new Emitter object, defaulting the
 // number of maximum listeners per event to the DefaultMaxListeners
-// constant and initializing its events map.
+// constant and initializing its events map. The zero value of an
+// Emitter is equally ready to use, allowing it to be embedded.
 func NewEmitter() (emitter *Emitter) {
 	emitter = new(Emitter)
-	emitter.Mutex = new(sync.Mutex)
-	emitter.events = make(map[interface{}][]reflect.Value)
-	emitter.maxListeners = DefaultMaxListeners
-	emitter.onces = make(map[reflect.Value]reflect.Value)
+	emitter.lazyInit()
 	return
 }
+
+// lazyInit initializes the Emitter's maps and defaults the number of
+// maximum listeners per event on first use, without taking its mutex.
+func (emitter *Emitter) lazyInit() {
+	emitter.initOnce.Do(func() {
+		emitter.handles = make(map[Handle]*listenerRecord)
+		emitter.maxListeners = DefaultMaxListeners
+		emitter.sticky = make(map[interface{}][]interface{})
+		emitter.histories = make(map[interface{}]*history)
+		emitter.handlers = make(map[interface{}]*listenerRecord)
+	})
+}
@@ -1,7 +1,10 @@
 package emission
 
 import (
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 func TestAddListener(t *testing.T) {
@@ -10,7 +13,7 @@ func TestAddListener(t *testing.T) {
 	emitter := NewEmitter().
 		AddListener(event, func() {})
 
-	if 1 != len(emitter.events[event]) {
+	if 1 != len(emitter.load().events[event]) {
 		t.Error("Failed to add listener to the emitter.")
 	}
 }
@@ -43,18 +46,19 @@ func TestEmitSync(t *testing.T) {
 
 func TestEmitWithMultipleListeners(t *testing.T) {
 	event := "test"
-	invoked := 0
+
+	var invoked atomic.Int64
 
 	NewEmitter().
 		AddListener(event, func() {
-			invoked = invoked + 1
+			invoked.Add(1)
 		}).
 		AddListener(event, func() {
-			invoked = invoked + 1
+			invoked.Add(1)
 		}).
 		Emit(event)
 
-	if invoked != 2 {
+	if invoked.Load() != 2 {
 		t.Error("Emit failed to call all listeners.")
 	}
 }
@@ -67,7 +71,7 @@ func TestRemoveListener(t *testing.T) {
 		AddListener(event, listener).
 		RemoveListener(event, listener)
 
-	if 0 != len(emitter.events[event]) {
+	if 0 != len(emitter.load().events[event]) {
 		t.Error("Failed to remove listener from the emitter.")
 	}
 }
@@ -86,6 +90,35 @@ func TestOnce(t *testing.T) {
 	}
 }
 
+func TestOnceConcurrent(t *testing.T) {
+	var (
+		calls atomic.Int64
+		group sync.WaitGroup
+	)
+
+	for i := 0; i < 100; i++ {
+		emitter := NewEmitter().Once("test", func() { calls.Add(1) })
+		start := make(chan struct{})
+
+		for j := 0; j < 8; j++ {
+			group.Add(1)
+
+			go func() {
+				defer group.Done()
+				<-start
+				emitter.EmitSync("test")
+			}()
+		}
+
+		close(start)
+		group.Wait()
+	}
+
+	if 100 != calls.Load() {
+		t.Error("Once called listener more than once under concurrent emissions.", calls.Load())
+	}
+}
+
 func TestRecoveryWith(t *testing.T) {
 	event := "test"
 	flag := true
@@ -152,3 +185,459 @@ func TestRemoveDoubleListener(t *testing.T) {
 
 	NewEmitter().On(event, fn1).On(event, fn1).RemoveListener(event, fn1)
 }
+
+func TestAny(t *testing.T) {
+	received := []interface{}{}
+
+	NewEmitter().
+		AddListener(Any, func(event interface{}, value int) {
+			received = append(received, event, value)
+		}).
+		EmitSync("first", 1).
+		EmitSync("second", 2)
+
+	if 4 != len(received) || "first" != received[0] || "second" != received[2] {
+		t.Error("Failed to call the Any listener for every emitted event.")
+	}
+}
+
+func TestAddListenerWithPriority(t *testing.T) {
+	event := "test"
+	received := []int{}
+
+	NewEmitter().
+		AddListener(event, func() { received = append(received, 0) }).
+		AddListenerWithPriority(event, func() { received = append(received, 2) }, 2).
+		AddListenerWithPriority(event, func() { received = append(received, 1) }, 1).
+		AddListenerWithPriority(event, func() { received = append(received, -1) }, -1).
+		EmitSync(event)
+
+	if 4 != len(received) || 2 != received[0] || 1 != received[1] || 0 != received[2] || -1 != received[3] {
+		t.Error("Failed to call listeners in order of priority.", received)
+	}
+}
+
+func TestPrependListener(t *testing.T) {
+	event := "test"
+	received := []int{}
+
+	NewEmitter().
+		AddListener(event, func() { received = append(received, 2) }).
+		PrependListener(event, func() { received = append(received, 1) }).
+		PrependOnceListener(event, func() { received = append(received, 0) }).
+		EmitSync(event).
+		EmitSync(event)
+
+	if 5 != len(received) || 0 != received[0] || 1 != received[1] || 2 != received[2] || 1 != received[3] {
+		t.Error("Failed to call prepended listeners first.", received)
+	}
+}
+
+func TestOnceMultipleListeners(t *testing.T) {
+	event := "test"
+	invoked := 0
+	fn1 := func() { invoked = invoked + 1 }
+	fn2 := func() { invoked = invoked + 10 }
+
+	NewEmitter().
+		Once(event, fn1).
+		Once(event, fn2).
+		RemoveListener(event, fn1).
+		EmitSync(event).
+		EmitSync(event)
+
+	if 10 != invoked {
+		t.Error("Failed to call the remaining Once listener exactly once.", invoked)
+	}
+}
+
+func TestMetaEvents(t *testing.T) {
+	event := "test"
+	received := []interface{}{}
+	listener := func() {}
+
+	NewEmitter().
+		AddListener(NewListenerEvent, func(event, listener interface{}) {
+			received = append(received, "new", event)
+		}).
+		AddListener(RemoveListenerEvent, func(event, listener interface{}) {
+			received = append(received, "remove", event)
+		}).
+		AddListener(event, listener).
+		RemoveListener(event, listener)
+
+	if 4 != len(received) || "new" != received[0] || event != received[1] || "remove" != received[2] {
+		t.Error("Failed to emit meta-events when adding and removing listeners.", received)
+	}
+}
+
+func TestRemoveAllListeners(t *testing.T) {
+	event := "test"
+
+	emitter := NewEmitter().
+		AddListener(event, func() {}).
+		AddListener(event, func() {}).
+		AddListener("other", func() {}).
+		RemoveAllListeners(event)
+
+	if 0 != emitter.GetListenerCount(event) || 1 != emitter.GetListenerCount("other") {
+		t.Error("Failed to remove all listeners of the event.")
+	}
+
+	emitter.Reset()
+
+	if 0 != emitter.GetListenerCount("other") {
+		t.Error("Failed to remove all listeners when resetting.")
+	}
+}
+
+func TestEventNames(t *testing.T) {
+	listener := func() {}
+
+	events := NewEmitter().
+		AddListener("first", listener).
+		AddListener("second", listener).
+		RemoveListener("second", listener).
+		EventNames()
+
+	if 1 != len(events) || "first" != events[0] {
+		t.Error("Failed to get the events with listeners.", events)
+	}
+}
+
+func TestHasListeners(t *testing.T) {
+	emitter := NewEmitter().
+		AddListener("test", func() {})
+
+	if !emitter.HasListeners("test") || emitter.HasListeners("fake") {
+		t.Error("Failed to report whether the event has listeners.")
+	}
+
+	emitter.AddListener(Any, func(event interface{}) {})
+
+	if !emitter.HasListeners("fake") {
+		t.Error("Failed to report listeners added for the Any event.")
+	}
+}
+
+func TestTimes(t *testing.T) {
+	event := "test"
+	invoked := 0
+
+	emitter := NewEmitter().
+		Times(event, 2, func() { invoked = invoked + 1 }).
+		EmitSync(event).
+		EmitSync(event).
+		EmitSync(event)
+
+	if 2 != invoked || 0 != emitter.GetListenerCount(event) {
+		t.Error("Failed to remove the listener after being called n times.", invoked)
+	}
+}
+
+func TestTimesInvalid(t *testing.T) {
+	event := "test"
+	recovered := []error{}
+
+	emitter := NewEmitter().
+		RecoverWith(func(event, listener interface{}, err error) { recovered = append(recovered, err) }).
+		Times(event, 0, func() {}).
+		Times(event, -1, func() {})
+
+	if 2 != len(recovered) || ErrInvalidTimes != recovered[0] || 0 != emitter.GetListenerCount(event) {
+		t.Error("Failed to reject a listener called fewer than once.", recovered)
+	}
+
+	defer func() {
+		if ErrInvalidTimes != recover() {
+			t.Error("Failed to panic when calling a listener fewer than once.")
+		}
+	}()
+
+	NewEmitter().Times(event, 0, func() {})
+}
+
+func TestOnWithTTL(t *testing.T) {
+	event := "test"
+	invoked := 0
+
+	emitter := NewEmitter().
+		OnWithTTL(event, func() { invoked = invoked + 1 }, 10*time.Millisecond).
+		EmitSync(event)
+
+	time.Sleep(20 * time.Millisecond)
+	emitter.EmitSync(event)
+
+	if 1 != invoked || 0 != emitter.GetListenerCount(event) {
+		t.Error("Failed to remove the listener after its ttl elapsed.", invoked)
+	}
+}
+
+func TestOnceWhen(t *testing.T) {
+	event := "test"
+	received := []int{}
+
+	emitter := NewEmitter().
+		OnceWhen(event, func(arguments ...interface{}) bool {
+			return arguments[0].(int) > 1
+		}, func(value int) { received = append(received, value) }).
+		EmitSync(event, 1).
+		EmitSync(event, 2).
+		EmitSync(event, 3)
+
+	if 1 != len(received) || 2 != received[0] || 0 != emitter.GetListenerCount(event) {
+		t.Error("Failed to call the listener once when the predicate was satisfied.", received)
+	}
+}
+
+func TestOnFiltered(t *testing.T) {
+	event := "test"
+	received := []int{}
+
+	NewEmitter().
+		OnFiltered(event, func(arguments ...interface{}) bool {
+			return 0 == arguments[0].(int)%2
+		}, func(value int) { received = append(received, value) }).
+		EmitSync(event, 1).
+		EmitSync(event, 2).
+		EmitSync(event, 4)
+
+	if 2 != len(received) || 2 != received[0] || 4 != received[1] {
+		t.Error("Failed to call the listener only for accepted arguments.", received)
+	}
+}
+
+func TestOnUnhandled(t *testing.T) {
+	received := []interface{}{}
+
+	NewEmitter().
+		OnUnhandled(func(event interface{}, arguments []interface{}) {
+			received = append(received, event, arguments[0])
+		}).
+		AddListener("handled", func(int) {}).
+		EmitSync("handled", 1).
+		EmitSync("unhandled", 2)
+
+	if 2 != len(received) || "unhandled" != received[0] || 2 != received[1] {
+		t.Error("Failed to call the unhandled listener for events without listeners.", received)
+	}
+}
+
+func TestTryEmit(t *testing.T) {
+	emitter := NewEmitter().
+		AddListener("test", func() {})
+
+	if nil != emitter.TryEmit("test") {
+		t.Error("TryEmit returned an error for an event with listeners.")
+	}
+
+	if ErrNoListeners != emitter.TryEmit("fake") {
+		t.Error("TryEmit failed to return ErrNoListeners for an event without listeners.")
+	}
+}
+
+func TestEmitCount(t *testing.T) {
+	emitter := NewEmitter().
+		AddListener("test", func() {}).
+		AddListener("test", func() {})
+
+	if 2 != emitter.EmitCount("test") || 0 != emitter.EmitCount("fake") {
+		t.Error("EmitCount failed to return the number of listeners dispatched.")
+	}
+}
+
+func TestEmitCanonicalListener(t *testing.T) {
+	event := "test"
+	received := []interface{}{}
+
+	NewEmitter().
+		AddListener(event, func(arguments ...interface{}) {
+			received = arguments
+		}).
+		EmitSync(event, 1, nil, "a")
+
+	if 3 != len(received) || 1 != received[0] || nil != received[1] || "a" != received[2] {
+		t.Error("Failed to call the canonical listener with the arguments.", received)
+	}
+}
+
+func TestEmitNilArgument(t *testing.T) {
+	event := "test"
+	flag := false
+
+	NewEmitter().
+		AddListener(event, func(err error, values []int) {
+			flag = nil == err && nil == values
+		}).
+		EmitSync(event, nil, nil)
+
+	if !flag {
+		t.Error("Failed to pass zero values for nil arguments.")
+	}
+}
+
+func BenchmarkEmitSync(b *testing.B) {
+	event := "test"
+	emitter := NewEmitter()
+
+	for i := 0; i < 5; i++ {
+		emitter.AddListener(event, func(a, b, c int) {})
+	}
+
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		emitter.EmitSync(event, 1, 2, 3)
+	}
+}
+
+func TestZeroValueEmitter(t *testing.T) {
+	var emitter Emitter
+
+	event := "test"
+	flag := false
+
+	if 0 != emitter.GetListenerCount(event) {
+		t.Error("Zero value emitter has listeners.")
+	}
+
+	emitter.
+		AddListener(event, func() { flag = true }).
+		Emit(event)
+
+	if !flag {
+		t.Error("Zero value emitter failed to call the listener.")
+	}
+
+	embedded := struct{ Emitter }{}
+	embedded.SetMaxListeners(1).On(event, func() {}).EmitSticky(event)
+
+	if 1 != embedded.GetListenerCount(event) {
+		t.Error("Embedded emitter failed to add the listener.")
+	}
+}
+
+func TestSetStrictMaxListeners(t *testing.T) {
+	event := "test"
+
+	var recovered error
+
+	emitter := NewEmitter().
+		SetMaxListeners(1).
+		SetStrictMaxListeners(true).
+		RecoverWith(func(event, listener interface{}, err error) { recovered = err }).
+		On(event, func() {}).
+		On(event, func() {})
+
+	if ErrMaxListeners != recovered || 1 != emitter.GetListenerCount(event) {
+		t.Error("Failed to refuse a listener beyond the maximum.", recovered)
+	}
+}
+
+func TestRecoveryWithPanicError(t *testing.T) {
+	var recovered error
+
+	NewEmitter().
+		RecoverWith(func(event, listener interface{}, err error) { recovered = err }).
+		On("test", func() { panic(42) }).
+		EmitSync("test")
+
+	err, ok := recovered.(*PanicError)
+
+	if !ok || 42 != err.Value || "42" != err.Error() || 0 == len(err.Stack) {
+		t.Error("Failed to supply the panic value and stack trace.", recovered)
+	}
+}
+
+func TestOnWithRecovery(t *testing.T) {
+	var own, shared error
+
+	NewEmitter().
+		RecoverWith(func(event, listener interface{}, err error) { shared = err }).
+		OnWithRecovery("test", func() { panic("test") }, func(event, listener interface{}, err error) { own = err }).
+		EmitSync("test")
+
+	if nil == own || nil != shared {
+		t.Error("Failed to recover with the listener's RecoveryListener.", own, shared)
+	}
+}
+
+func TestAddRecoveryListener(t *testing.T) {
+	calls := []string{}
+
+	NewEmitter().
+		RecoverWith(func(event, listener interface{}, err error) { calls = append(calls, "first") }).
+		AddRecoveryListener(func(event, listener interface{}, err error) { calls = append(calls, "second") }).
+		On("test", func() { panic("test") }).
+		EmitSync("test")
+
+	if 2 != len(calls) || "first" != calls[0] || "second" != calls[1] {
+		t.Error("Failed to call each RecoveryListener in order.", calls)
+	}
+}
+
+func TestRecoverWithConcurrently(t *testing.T) {
+	event := "test"
+	done := make(chan struct{})
+
+	emitter := NewEmitter().
+		RecoverWith(func(event, listener interface{}, err error) {}).
+		On(event, func() { panic("test") })
+
+	go func() {
+		defer close(done)
+
+		for i := 0; i < 100; i++ {
+			emitter.RecoverWith(func(event, listener interface{}, err error) {})
+		}
+	}()
+
+	for i := 0; i < 100; i++ {
+		emitter.Emit(event)
+	}
+
+	<-done
+}
+
+func TestTryAddListener(t *testing.T) {
+	emitter := NewEmitter().SetMaxListeners(1)
+
+	if err := emitter.TryAddListener("test", "listener"); ErrNotAFunction != err {
+		t.Error("Failed to return ErrNotAFunction.", err)
+	}
+
+	if err := emitter.TryAddListener("test", func() {}); nil != err {
+		t.Error("Failed to add the listener.", err)
+	}
+
+	if err := emitter.TryAddListener("test", func() {}); ErrMaxListeners != err || 1 != emitter.GetListenerCount("test") {
+		t.Error("Failed to return ErrMaxListeners.", err)
+	}
+
+	emitter.Close()
+
+	if err := emitter.TryAddListener("test", func() {}); ErrClosed != err {
+		t.Error("Failed to return ErrClosed.", err)
+	}
+}
+
+func TestTryEmitArgumentMismatch(t *testing.T) {
+	called := false
+
+	emitter := NewEmitter().
+		On("test", func(n int, names ...string) { called = true })
+
+	for _, arguments := range [][]interface{}{{}, {"a"}, {1, 2}} {
+		if err := emitter.TryEmit("test", arguments...); ErrArgumentMismatch != err {
+			t.Error("Failed to return ErrArgumentMismatch.", arguments, err)
+		}
+	}
+
+	if called {
+		t.Error("Called a listener despite mismatched arguments.")
+	}
+
+	if err := emitter.TryEmit("test", 1, "a", "b"); nil != err || !called {
+		t.Error("Failed to emit aligned arguments.", err)
+	}
+}
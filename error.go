@@ -0,0 +1,111 @@
+package emission
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+)
+
+// Type of error used to detect listeners returning an error.
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// ErrorEvent is the event errors are emitted on by EmitError.
+const ErrorEvent reservedEvent = "error"
+
+// reservedEvent is the type of events reserved by the Emitter, keeping
+// them distinct from user events of the same value.
+type reservedEvent string
+
+// EmitError synchronously emits the error on the ErrorEvent. If no listener
+// has been added for the ErrorEvent, the RecoveryListener is called with
+// the error if one has been set, else the error is returned.
+func (emitter *Emitter) EmitError(err error) error {
+	emitter.RLock()
+	handled := 0 != len(emitter.load().events[ErrorEvent])
+	recoverer := emitter.recovery()
+	emitter.RUnlock()
+
+	switch {
+	case handled:
+		emitter.EmitSync(ErrorEvent, err)
+	case nil != recoverer:
+		recoverer(ErrorEvent, nil, err)
+	default:
+		return err
+	}
+
+	return nil
+}
+
+// EmitSyncE calls each listener synchronously as EmitSync does, returning
+// the errors returned by the listeners joined by errors.Join. A listener
+// returns an error when its last return value is of type error. As with
+// EmitCollect, the emission is never deferred.
+func (emitter *Emitter) EmitSyncE(event interface{}, arguments ...interface{}) error {
+	var errs []error
+
+	emitter.emitNow(nil, Event{Name: event, Args: arguments}, func(ctx context.Context, event interface{}, listeners []*listenerRecord, arguments []interface{}) {
+		for _, l := range listeners {
+			results, _ := emitter.call(ctx, event, l, arguments)
+
+			if err := listenerError(results); nil != err {
+				errs = append(errs, err)
+			}
+		}
+	})
+
+	return errors.Join(errs...)
+}
+
+// EmitE calls each listener within its own go routine as Emit does, waiting
+// for them all to return, and returns the errors returned by the listeners
+// joined by errors.Join as EmitSyncE does. The emission is never deferred.
+func (emitter *Emitter) EmitE(event interface{}, arguments ...interface{}) error {
+	var (
+		mutex sync.Mutex
+		errs  []error
+	)
+
+	emitter.emitNow(nil, Event{Name: event, Args: arguments}, func(ctx context.Context, event interface{}, listeners []*listenerRecord, arguments []interface{}) {
+		var wg sync.WaitGroup
+
+		wg.Add(len(listeners))
+
+		for _, l := range listeners {
+			l := l
+
+			emitter.spawn(event, l, func() {
+				defer wg.Done()
+
+				results, _ := emitter.call(ctx, event, l, arguments)
+
+				if err := listenerError(results); nil != err {
+					mutex.Lock()
+					errs = append(errs, err)
+					mutex.Unlock()
+				}
+			})
+		}
+
+		wg.Wait()
+	})
+
+	return errors.Join(errs...)
+}
+
+// listenerError returns the error a listener returned as its last return
+// value, if any.
+func listenerError(results []reflect.Value) error {
+	if 0 == len(results) {
+		return nil
+	}
+
+	last := results[len(results)-1]
+
+	if errorType != last.Type() || last.IsNil() {
+		return nil
+	}
+
+	return last.Interface().(error)
+}
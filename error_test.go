@@ -0,0 +1,79 @@
+package emission
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestEmitError(t *testing.T) {
+	err := errors.New("test")
+	var received error
+
+	emitter := NewEmitter()
+
+	if err != emitter.EmitError(err) {
+		t.Error("Failed to return the error without listeners or a RecoveryListener.")
+	}
+
+	emitter.RecoverWith(func(event, listener interface{}, err error) { received = err })
+
+	if nil != emitter.EmitError(err) || err != received {
+		t.Error("Failed to call the RecoveryListener with the error.")
+	}
+
+	received = nil
+	emitter.AddListener(ErrorEvent, func(err error) { received = err })
+
+	if nil != emitter.EmitError(err) || err != received {
+		t.Error("Failed to call the ErrorEvent listener with the error.")
+	}
+}
+
+func TestEmitE(t *testing.T) {
+	event := "test"
+	errA, errB := errors.New("a"), errors.New("b")
+
+	emitter := NewEmitter().
+		On(event, func() error { return errA }).
+		On(event, func() (int, error) { return 0, nil }).
+		On(event, func() (int, error) { return 1, errB }).
+		On(event, func() {})
+
+	for _, err := range []error{emitter.EmitSyncE(event), emitter.EmitE(event)} {
+		if !errors.Is(err, errA) || !errors.Is(err, errB) {
+			t.Error("Failed to join the errors returned by the listeners.", err)
+		}
+	}
+
+	if nil != NewEmitter().On(event, func() error { return nil }).EmitSyncE(event) {
+		t.Error("Failed to return nil when no listener returned an error.")
+	}
+}
+
+func TestEmitEDeferred(t *testing.T) {
+	err := errors.New("test")
+
+	emitter := NewEmitter().
+		SetEventLoop(true).
+		On("test", func() error { return err })
+
+	if !errors.Is(emitter.EmitSyncE("test"), err) {
+		t.Error("Failed to return the error with the event loop enabled.")
+	}
+
+	if !errors.Is(emitter.EmitE("test"), err) {
+		t.Error("Failed to return the error of the concurrent emission with the event loop enabled.")
+	}
+
+	emitter.Pause()
+
+	if !errors.Is(emitter.EmitSyncE("test"), err) {
+		t.Error("Failed to return the error while paused.")
+	}
+
+	if 1 != len(emitter.EmitCollect("test")) {
+		t.Error("Failed to collect the results while paused.")
+	}
+
+	emitter.Resume().Close()
+}
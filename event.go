@@ -0,0 +1,164 @@
+package emission
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+// Event is the envelope of an emission. Listeners of type func(Event)
+// receive the envelope of each emission instead of its arguments.
+type Event struct {
+	// The event emitted.
+	Name interface{}
+	// The arguments the event was emitted with.
+	Args []interface{}
+	// Time of the emission.
+	Timestamp time.Time
+	// Identifier of the emission, unique to the Emitter.
+	ID uint64
+	// Position of the emission among the Emitter's emissions of the event,
+	// starting at 1, if listeners have been added for the event itself, else
+	// 0. Emissions dropped before reaching listeners, such as by sampling or
+	// debouncing, leave gaps in the sequence, and it restarts once the
+	// event's last listener is removed.
+	Sequence uint64
+	// Identifier correlating the emission with others, if any.
+	CorrelationID string
+	// Metadata accompanying the emission, if any.
+	Metadata map[string]string
+	// Number of emissions the emission is nested within, emitted with the
+	// context of a listener of each.
+	depth int
+	// Function called if the emission ends without its listeners being
+	// dispatched, if any.
+	discarded func()
+}
+
+// envelopeKey is the context key the Event of an emission is stored under.
+type envelopeKey struct{}
+
+// EmitEvent emits the Event's Name with its Args, calling each listener
+// synchronously as EmitContext does. Listeners of type func(Event) receive
+// the Event with its Args as passed to listeners. Its Timestamp defaults to
+// the time of the emission, its ID and Sequence to ones assigned by the
+// Emitter and its CorrelationID to none.
+func (emitter *Emitter) EmitEvent(e Event) *Emitter {
+	e.discarded = nil
+	emitter.emit(nil, e, emitter.canceling)
+	return emitter
+}
+
+// EventFromContext returns the Event held by the context of an emission,
+// reporting whether there is one. Listeners accepting a context.Context are
+// passed the context of each emission.
+func EventFromContext(ctx context.Context) (Event, bool) {
+	e, ok := ctx.Value(envelopeKey{}).(Event)
+	return e, ok
+}
+
+// stamp returns a context derived from ctx, or from context.Background if
+// ctx is nil, holding the Event of an emission. The Event is assigned the
+// Emitter's next ID, the event's next sequence number and the time of the
+// emission unless it has them, the correlation ID held by ctx unless it has
+// one, and a depth one greater than that of the Event held by ctx, if any.
+func (emitter *Emitter) stamp(ctx context.Context, e Event) context.Context {
+	if nil == ctx {
+		ctx = context.Background()
+	}
+
+	e.depth = 0
+
+	if parent, ok := EventFromContext(ctx); ok {
+		e.depth = parent.depth + 1
+	}
+
+	if 0 == e.ID {
+		e.ID = emitter.emissions.Add(1)
+	}
+
+	if 0 == e.Sequence {
+		e.Sequence = emitter.sequence(e.Name)
+	}
+
+	if e.Timestamp.IsZero() {
+		e.Timestamp = time.Now()
+	}
+
+	if "" == e.CorrelationID {
+		e.CorrelationID = CorrelationID(ctx)
+	}
+
+	return context.WithValue(ctx, envelopeKey{}, e)
+}
+
+// stamps reports whether the emission of the Event must be stamped on its
+// context, because the Event may be observed by a listener or middleware,
+// its depth is tracked or it must be notified when discarded. Other
+// emissions are left unstamped, sparing them the cost.
+func (emitter *Emitter) stamps(e Event) bool {
+	t := emitter.load()
+	return t.observed || 0 != len(t.middleware) || 0 < t.maxDepth || nil != e.discarded
+}
+
+// envelope returns the envelope of the emission of the event with the
+// arguments, based on the Event held by ctx if any.
+func envelope(ctx context.Context, event interface{}, arguments []interface{}) Event {
+	var e Event
+
+	if nil != ctx {
+		e, _ = EventFromContext(ctx)
+	}
+
+	if e.Timestamp.IsZero() {
+		e.Timestamp = time.Now()
+	}
+
+	e.Name = event
+	e.Args = arguments
+	return e
+}
+
+// sequence returns the next sequence number of the event's emissions,
+// without taking the Emitter's mutex, or 0 if the event has no listeners
+// added for it. Counting only such events keeps the Emitter from holding
+// a counter for every event ever emitted, such as dynamically named ones.
+func (emitter *Emitter) sequence(event interface{}) uint64 {
+	if 0 == len(emitter.load().events[event]) {
+		return 0
+	}
+
+	counter, ok := emitter.sequences.Load(event)
+
+	if !ok {
+		counter, _ = emitter.sequences.LoadOrStore(event, new(atomic.Uint64))
+	}
+
+	return counter.(*atomic.Uint64).Add(1)
+}
+
+// forgetSequences drops the sequence numbers of the events, or of every
+// event if none are supplied.
+func (emitter *Emitter) forgetSequences(events ...interface{}) {
+	if 0 != len(events) {
+		for _, event := range events {
+			emitter.sequences.Delete(event)
+		}
+
+		return
+	}
+
+	emitter.sequences.Range(func(event, _ interface{}) bool {
+		emitter.sequences.Delete(event)
+		return true
+	})
+}
+
+// discard notifies the emission whose Event is held by ctx that it ends
+// without its listeners being dispatched, such as when it is dropped after
+// being deferred.
+func discard(ctx context.Context) {
+	if e, ok := EventFromContext(ctx); ok && nil != e.discarded {
+		e.discarded()
+	}
+}
@@ -0,0 +1,190 @@
+package emission
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestEmitEvent(t *testing.T) {
+	var received, fromContext Event
+
+	NewEmitter().
+		On("test", func(e Event) { received = e }).
+		On("test", func(ctx context.Context, n int) { fromContext, _ = EventFromContext(ctx) }).
+		EmitEvent(Event{Name: "test", Args: []interface{}{1}, ID: 7, Metadata: map[string]string{"a": "b"}})
+
+	if "test" != received.Name || 1 != len(received.Args) || 1 != received.Args[0] || 7 != received.ID || "b" != received.Metadata["a"] || received.Timestamp.IsZero() {
+		t.Error("Failed to pass the Event to the listener.", received)
+	}
+
+	if 7 != fromContext.ID {
+		t.Error("Failed to hold the Event in the context.", fromContext)
+	}
+}
+
+func TestEventListener(t *testing.T) {
+	var received Event
+
+	NewEmitter().
+		On("test", func(e Event) { received = e }).
+		EmitSync("test", 1, 2)
+
+	if "test" != received.Name || 2 != len(received.Args) || received.Timestamp.IsZero() {
+		t.Error("Failed to pass the envelope of an emission to the listener.", received)
+	}
+}
+
+func TestEmissionSequence(t *testing.T) {
+	var first, second []uint64
+
+	emitter := NewEmitter().
+		On("first", func(e Event) { first = append(first, e.Sequence) }).
+		On("second", func(ctx context.Context) {
+			e, _ := EventFromContext(ctx)
+			second = append(second, e.Sequence)
+		})
+
+	emitter.EmitSync("first").EmitSync("second").EmitSync("first").Emit("first")
+	emitter.EmitEvent(Event{Name: "second", Sequence: 7})
+
+	if 3 != len(first) || 1 != first[0] || 2 != first[1] || 3 != first[2] {
+		t.Error("Failed to number the emissions of the event in sequence.", first)
+	}
+
+	if 2 != len(second) || 1 != second[0] || 7 != second[1] {
+		t.Error("Failed to number the emissions of each event separately.", second)
+	}
+}
+
+func TestEmissionSequenceConcurrent(t *testing.T) {
+	var (
+		mutex    sync.Mutex
+		received = map[uint64]bool{}
+		group    sync.WaitGroup
+	)
+
+	emitter := NewEmitter().
+		On("test", func(e Event) {
+			mutex.Lock()
+			received[e.Sequence] = true
+			mutex.Unlock()
+		})
+
+	for i := 0; i < 8; i++ {
+		group.Add(1)
+
+		go func() {
+			defer group.Done()
+
+			for j := 0; j < 100; j++ {
+				emitter.EmitSync("test")
+			}
+		}()
+	}
+
+	group.Wait()
+
+	for sequence := uint64(1); sequence <= 800; sequence++ {
+		if !received[sequence] {
+			t.Fatal("Failed to number concurrent emissions without gaps or duplicates.", sequence)
+		}
+	}
+	emitter.Close()
+
+	emitter.sequences.Range(func(event, _ interface{}) bool {
+		t.Error("Failed to clear the sequence numbers once closed.", event)
+		return false
+	})
+}
+
+func TestEmitWithoutMutex(t *testing.T) {
+	done := make(chan struct{})
+
+	emitter := NewEmitter().
+		On("test", func(e Event) {}).
+		On("test", func() {})
+
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	go func() {
+		defer close(done)
+		emitter.EmitSync("test").Emit("test")
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Error("Failed to emit without taking the Emitter's mutex.")
+	}
+}
+
+func TestEmissionID(t *testing.T) {
+	var ids []uint64
+
+	emitter := NewEmitter().
+		On("test", func(e Event) { ids = append(ids, e.ID) })
+
+	emitter.EmitSync("test").EmitSync("test").Emit("test")
+
+	if 3 != len(ids) || 1 != ids[0] || 2 != ids[1] || 3 != ids[2] {
+		t.Error("Failed to assign each emission a unique ID.", ids)
+	}
+}
+
+func TestStampObserved(t *testing.T) {
+	event := "test"
+	listener := func(e Event) {}
+
+	emitter := NewEmitter().AddListener(event, func(int) {})
+
+	if emitter.stamps(Event{Name: event}) {
+		t.Error("Stamped an emission no listener observes.")
+	}
+
+	emitter.AddListener(event, listener)
+
+	if !emitter.stamps(Event{Name: event}) {
+		t.Error("Failed to stamp an emission observed by a listener.")
+	}
+
+	emitter.RemoveListener(event, listener)
+
+	if emitter.stamps(Event{Name: event}) {
+		t.Error("Stamped an emission once its observing listener was removed.")
+	}
+}
+
+func TestSequenceForgotten(t *testing.T) {
+	event := "test"
+	sequences := []uint64{}
+	listener := func(e Event) { sequences = append(sequences, e.Sequence) }
+
+	emitter := NewEmitter().
+		AddListener(Any, listener).
+		AddListener(event, func(int) {})
+
+	emitter.EmitSync("user.1", 1).EmitSync(event, 1).EmitSync(event, 2)
+
+	if 3 != len(sequences) || 0 != sequences[0] || 1 != sequences[1] || 2 != sequences[2] {
+		t.Error("Failed to number only the emissions of events with listeners.", sequences)
+	}
+
+	if _, ok := emitter.sequences.Load("user.1"); ok {
+		t.Error("Kept the sequence number of an event without listeners.")
+	}
+
+	emitter.RemoveAllListeners(event)
+
+	if _, ok := emitter.sequences.Load(event); ok {
+		t.Error("Kept the sequence number of an event once its listeners were removed.")
+	}
+
+	emitter.AddListener(event, func(int) {}).EmitSync(event, 3).Reset()
+
+	if _, ok := emitter.sequences.Load(event); ok {
+		t.Error("Kept the sequence number of an event once the Emitter was reset.")
+	}
+}
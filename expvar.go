@@ -0,0 +1,47 @@
+package emission
+
+import (
+	"expvar"
+	"sync/atomic"
+	"time"
+)
+
+// counters is an Observer counting the Emitter's activity.
+type counters struct {
+	emits, calls, panics atomic.Int64
+}
+
+// Emitted counts an emission.
+func (c *counters) Emitted(event interface{}) {
+	c.emits.Add(1)
+}
+
+// Called counts a listener call.
+func (c *counters) Called(event interface{}, duration time.Duration) {
+	c.calls.Add(1)
+}
+
+// Panicked counts a listener panic.
+func (c *counters) Panicked(event interface{}) {
+	c.panics.Add(1)
+}
+
+// Publish publishes the Emitter's counters through expvar under the name:
+// the total number of emissions delivered and of listener calls, the number
+// of listeners currently running within their own go routine and the total
+// number of listener panics. Like expvar.Publish, Publish panics if the
+// name is already in use.
+func (emitter *Emitter) Publish(name string) *Emitter {
+	c := &counters{}
+
+	expvar.Publish(name, expvar.Func(func() interface{} {
+		return map[string]int64{
+			"emits":  c.emits.Load(),
+			"calls":  c.calls.Load(),
+			"active": emitter.inflight.Load(),
+			"panics": c.panics.Load(),
+		}
+	}))
+
+	return emitter.AddObserver(c)
+}
@@ -0,0 +1,35 @@
+package emission
+
+import (
+	"encoding/json"
+	"expvar"
+	"fmt"
+	"sync/atomic"
+	"testing"
+)
+
+// Number of runs of TestPublish, making each run's expvar name unique as
+// expvar names cannot be reused within the process.
+var publishRuns atomic.Int64
+
+func TestPublish(t *testing.T) {
+	event := "test"
+	name := fmt.Sprintf("emission_test_%d", publishRuns.Add(1))
+
+	NewEmitter().
+		Publish(name).
+		RecoverWith(func(event, listener interface{}, err error) {}).
+		On(event, func() {}).
+		On(event, func() { panic("test") }).
+		EmitSync(event)
+
+	counters := map[string]int64{}
+
+	if err := json.Unmarshal([]byte(expvar.Get(name).String()), &counters); nil != err {
+		t.Fatal("Failed to read the published counters.", err)
+	}
+
+	if 1 != counters["emits"] || 2 != counters["calls"] || 0 != counters["active"] || 1 != counters["panics"] {
+		t.Error("Failed to publish the Emitter's counters.", counters)
+	}
+}
@@ -0,0 +1,78 @@
+package emission
+
+import (
+	"sync"
+)
+
+// fifo is an unbounded queue of calls of a single listener, processed in
+// the order they were posted by a go routine started while it holds calls.
+type fifo struct {
+	// Mutex guarding the queue.
+	mutex sync.Mutex
+	// Queued calls of the listener.
+	jobs []func()
+	// Whether a go routine is processing the queue.
+	running bool
+	// Whether the queue has been closed.
+	closed bool
+}
+
+// post queues the job, starting a go routine to process the queue unless
+// one is running. It reports false without queuing the job if the queue
+// has been closed.
+func (q *fifo) post(job func()) bool {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if q.closed {
+		return false
+	}
+
+	q.jobs = append(q.jobs, job)
+
+	if !q.running {
+		q.running = true
+		go q.run()
+	}
+
+	return true
+}
+
+// run calls the queued jobs in order until the queue is empty.
+func (q *fifo) run() {
+	for {
+		q.mutex.Lock()
+
+		if 0 == len(q.jobs) {
+			q.running = false
+			q.mutex.Unlock()
+			return
+		}
+
+		job := q.jobs[0]
+		q.jobs[0] = nil
+		q.jobs = q.jobs[1:]
+		q.mutex.Unlock()
+
+		job()
+	}
+}
+
+// close closes the queue, the calls already queued still being processed.
+func (q *fifo) close() {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	q.closed = true
+}
+
+// WithFIFO queues the listener's calls which would be made within their own
+// go routine, as by Emit and EmitAsync, calling them one at a time in the
+// order the events were emitted. Unlike a mailbox the queue is unbounded,
+// so emitting never blocks on the listener, and Emit does not wait for
+// queued calls of the listener to return.
+func WithFIFO() ListenerOption {
+	return func(l *listenerRecord) {
+		l.fifo = &fifo{}
+	}
+}
@@ -0,0 +1,64 @@
+package emission
+
+import (
+	"testing"
+)
+
+func TestWithFIFO(t *testing.T) {
+	var received []int
+
+	emitter := NewEmitter().
+		OnWith("test", func(value int) { received = append(received, value) }, WithFIFO())
+
+	for i := 0; i < 100; i++ {
+		emitter.EmitAsync("test", i)
+	}
+
+	emitter.Wait()
+
+	if 100 != len(received) {
+		t.Fatal("Failed to call the listener for each emission.", len(received))
+	}
+
+	for i, value := range received {
+		if i != value {
+			t.Fatal("Failed to call the listener in the order the events were emitted.", received)
+		}
+	}
+}
+
+func TestWithFIFOReentrant(t *testing.T) {
+	var received []int
+
+	emitter := NewEmitter()
+
+	emitter.OnWith("test", func(value int) {
+		received = append(received, value)
+
+		if 3 > value {
+			emitter.Emit("test", value+1)
+		}
+	}, WithFIFO())
+
+	emitter.Emit("test", 0).Wait()
+
+	if 4 != len(received) || 3 != received[3] {
+		t.Error("Failed to emit from within a queued listener.", received)
+	}
+}
+
+func TestWithFIFORemoved(t *testing.T) {
+	called := false
+	listener := func() { called = true }
+
+	emitter := NewEmitter().OnWith("test", listener, WithFIFO())
+	record := emitter.listenersFor("test")[0]
+
+	emitter.RemoveListener("test", listener)
+	emitter.spawn("test", record, listener)
+	emitter.Wait()
+
+	if called {
+		t.Error("Failed to drop calls of a removed listener.")
+	}
+}
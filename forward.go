@@ -0,0 +1,75 @@
+package emission
+
+import (
+	"context"
+)
+
+// forwardedKey is the context key the Emitters an emission was forwarded
+// from are stored under.
+type forwardedKey struct{}
+
+// Mapper maps an event emitted on one Emitter and its arguments to the
+// event and arguments forwarded to another.
+type Mapper func(event interface{}, arguments []interface{}) (interface{}, []interface{})
+
+// Forward forwards the events emitted on src to dst, emitting each on dst
+// with the same arguments as Emit does, along with the metadata and
+// correlation ID of the emission. If no events are given, every event
+// emitted on src is forwarded. The returned function stops forwarding.
+// Emissions are never forwarded to an Emitter they were forwarded from,
+// directly or through other Emitters, so that Emitters may forward events
+// to each other.
+func Forward(src, dst *Emitter, events ...interface{}) func() {
+	return ForwardMapped(src, dst, nil, events...)
+}
+
+// ForwardMapped forwards the events emitted on src to dst as Forward does,
+// emitting the event and arguments returned by the mapper, if non-nil, in
+// place of those emitted on src. Emissions for which the mapper returns a
+// nil event are not forwarded.
+func ForwardMapped(src, dst *Emitter, mapper Mapper, events ...interface{}) func() {
+	if 0 == len(events) {
+		events = []interface{}{Any}
+	}
+
+	var records []*listenerRecord
+
+	for _, event := range events {
+		record := src.addListener(event, func(ctx context.Context, event interface{}, arguments ...interface{}) {
+			if nil != mapper {
+				if event, arguments = mapper(event, arguments); nil == event {
+					return
+				}
+			}
+
+			forwarded, _ := ctx.Value(forwardedKey{}).([]*Emitter)
+			forwarded = append(forwarded[:len(forwarded):len(forwarded)], src)
+
+			for _, emitter := range forwarded {
+				if dst == emitter {
+					return
+				}
+			}
+
+			ctx = context.WithValue(ctx, forwardedKey{}, forwarded)
+			e, _ := EventFromContext(ctx)
+
+			dst.emit(ctx, Event{
+				Name:          event,
+				Args:          arguments,
+				Metadata:      e.Metadata,
+				CorrelationID: e.CorrelationID,
+			}, dst.parallel)
+		}, &listenerRecord{withEvent: true}, false)
+
+		records = append(records, record)
+	}
+
+	return func() {
+		for _, record := range records {
+			if nil != record {
+				src.removeRecord(record)
+			}
+		}
+	}
+}
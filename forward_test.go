@@ -0,0 +1,81 @@
+package emission
+
+import (
+	"testing"
+)
+
+func TestForward(t *testing.T) {
+	src, dst := NewEmitter(), NewEmitter()
+	received := []int{}
+
+	dst.
+		AddListener("a", func(n int) { received = append(received, n) }).
+		AddListener("b", func(n int) { received = append(received, -n) })
+
+	stop := Forward(src, dst, "a")
+
+	src.EmitSync("a", 1).EmitSync("b", 2)
+
+	if 1 != len(received) || 1 != received[0] {
+		t.Error("Failed to forward only the events given.", received)
+	}
+
+	stop()
+	src.EmitSync("a", 3)
+
+	if 1 != len(received) {
+		t.Error("Forwarded an event once stopped.", received)
+	}
+}
+
+func TestForwardAll(t *testing.T) {
+	src, dst := NewEmitter(), NewEmitter()
+	var received Event
+
+	dst.On("test", func(e Event) { received = e })
+
+	Forward(src, dst)
+
+	src.EmitWithMeta("test", map[string]string{"key": "value"}, 1)
+
+	if "test" != received.Name || 1 != received.Args[0] || "value" != received.Metadata["key"] {
+		t.Error("Failed to forward every event with its metadata.", received)
+	}
+}
+
+func TestForwardMapped(t *testing.T) {
+	src, dst := NewEmitter(), NewEmitter()
+	received := []string{}
+
+	dst.AddListener("user.created", func(name string) { received = append(received, name) })
+
+	ForwardMapped(src, dst, func(event interface{}, arguments []interface{}) (interface{}, []interface{}) {
+		if "signup" != event {
+			return nil, nil
+		}
+
+		return "user.created", []interface{}{arguments[0].(string) + "!"}
+	})
+
+	src.EmitSync("signup", "alice").EmitSync("login", "bob")
+
+	if 1 != len(received) || "alice!" != received[0] {
+		t.Error("Failed to forward the mapped event and arguments.", received)
+	}
+}
+
+func TestForwardCycle(t *testing.T) {
+	a, b := NewEmitter(), NewEmitter()
+	received := 0
+
+	b.AddListener("test", func() { received = received + 1 })
+
+	Forward(a, b)
+	Forward(b, a)
+
+	a.EmitSync("test")
+
+	if 1 != received {
+		t.Error("Failed to forward the event once between Emitters forwarding to each other.", received)
+	}
+}
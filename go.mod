@@ -0,0 +1,3 @@
+module github.com/chuckpreslar/emission
+
+go 1.21
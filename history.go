@@ -0,0 +1,117 @@
+package emission
+
+import (
+	"reflect"
+)
+
+// history is a ring buffer of the arguments of an event's latest emissions.
+type history struct {
+	// Recorded arguments, in the order they were recorded once full.
+	entries [][]interface{}
+	// Index of the oldest entry once the buffer is full.
+	next int
+}
+
+// record adds the arguments to the history, replacing the oldest entry
+// once the history holds size entries.
+func (h *history) record(arguments []interface{}, size int) {
+	if len(h.entries) < size {
+		h.entries = append(h.entries, arguments)
+		return
+	}
+
+	h.entries[h.next] = arguments
+	h.next = (h.next + 1) % size
+}
+
+// list returns the recorded arguments from the oldest to the latest.
+func (h *history) list() [][]interface{} {
+	list := make([][]interface{}, 0, len(h.entries))
+	list = append(list, h.entries[h.next:]...)
+	return append(list, h.entries[:h.next]...)
+}
+
+// SetHistorySize sets the number of latest emissions recorded per event,
+// which are available from History and Replay. A size of 0, the default,
+// disables recording. Changing the size discards the recorded emissions.
+func (emitter *Emitter) SetHistorySize(size int) *Emitter {
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	emitter.historySize = size
+	emitter.histories = make(map[interface{}]*history)
+	emitter.publish()
+	return emitter
+}
+
+// History returns the arguments of the event's latest recorded emissions,
+// from the oldest to the latest.
+func (emitter *Emitter) History(event interface{}) [][]interface{} {
+	if emitter.rejectEvent(event) {
+		return [][]interface{}{}
+	}
+
+	emitter.RLock()
+	defer emitter.RUnlock()
+
+	if h, ok := emitter.histories[event]; ok {
+		return h.list()
+	}
+
+	return [][]interface{}{}
+}
+
+// Replay synchronously calls the listener with the arguments of each of the
+// event's recorded emissions, from the oldest to the latest. If the reflect
+// Value of the listener does not have a Kind of Func then Replay panics. If
+// a RecoveryListener has been set then it is called after recovering from
+// the panic.
+func (emitter *Emitter) Replay(event, listener interface{}) *Emitter {
+	if emitter.rejectEvent(event) {
+		return emitter
+	}
+
+	fn := reflect.ValueOf(listener)
+
+	if reflect.Func != fn.Kind() {
+		if recoverer := emitter.recovery(); nil == recoverer {
+			panic(ErrNoneFunction)
+		} else {
+			recoverer(event, listener, ErrNoneFunction)
+			return emitter
+		}
+	}
+
+	record := &listenerRecord{event: event}
+	record.setFunc(fn)
+
+	for _, arguments := range emitter.History(event) {
+		emitter.call(nil, event, record, arguments)
+	}
+
+	return emitter
+}
+
+// recordHistory records the arguments of the event's emission if the
+// Emitter keeps a history.
+func (emitter *Emitter) recordHistory(event interface{}, arguments []interface{}) {
+	if 0 >= emitter.load().historySize {
+		return
+	}
+
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	if 0 >= emitter.historySize {
+		return
+	}
+
+	h, ok := emitter.histories[event]
+
+	if !ok {
+		h = &history{}
+		emitter.histories[event] = h
+	}
+
+	h.record(arguments, emitter.historySize)
+}
@@ -0,0 +1,36 @@
+package emission
+
+import (
+	"testing"
+)
+
+func TestHistory(t *testing.T) {
+	event := "test"
+
+	emitter := NewEmitter().
+		SetHistorySize(2).
+		Emit(event, 1).
+		Emit(event, 2).
+		Emit(event, 3)
+
+	history := emitter.History(event)
+
+	if 2 != len(history) || 2 != history[0][0] || 3 != history[1][0] {
+		t.Error("Failed to record the latest emissions of the event.", history)
+	}
+}
+
+func TestReplay(t *testing.T) {
+	event := "test"
+	received := []int{}
+
+	NewEmitter().
+		SetHistorySize(5).
+		Emit(event, 1).
+		Emit(event, 2).
+		Replay(event, func(value int) { received = append(received, value) })
+
+	if 2 != len(received) || 1 != received[0] || 2 != received[1] {
+		t.Error("Failed to replay the recorded emissions to the listener.", received)
+	}
+}
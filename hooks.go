@@ -0,0 +1,27 @@
+package emission
+
+// EmitHook is called around the emission of an event with the event and
+// the arguments it was emitted with.
+type EmitHook func(event interface{}, arguments []interface{})
+
+// OnBeforeEmit adds the hook to be called before each emission of an
+// event, ahead of any middleware.
+func (emitter *Emitter) OnBeforeEmit(hook EmitHook) *Emitter {
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	emitter.beforeHooks = append(emitter.beforeHooks, hook)
+	emitter.publish()
+	return emitter
+}
+
+// OnAfterEmit adds the hook to be called after each emission of an event,
+// once its middleware and listeners have returned.
+func (emitter *Emitter) OnAfterEmit(hook EmitHook) *Emitter {
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	emitter.afterHooks = append(emitter.afterHooks, hook)
+	emitter.publish()
+	return emitter
+}
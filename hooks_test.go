@@ -0,0 +1,24 @@
+package emission
+
+import (
+	"testing"
+)
+
+func TestEmitHooks(t *testing.T) {
+	event := "test"
+	received := []string{}
+
+	NewEmitter().
+		OnBeforeEmit(func(event interface{}, arguments []interface{}) {
+			received = append(received, "before")
+		}).
+		OnAfterEmit(func(event interface{}, arguments []interface{}) {
+			received = append(received, "after")
+		}).
+		AddListener(event, func() { received = append(received, "listener") }).
+		Emit(event)
+
+	if 3 != len(received) || "before" != received[0] || "listener" != received[1] || "after" != received[2] {
+		t.Error("Failed to call hooks around the emission.", received)
+	}
+}
@@ -0,0 +1,79 @@
+package emission
+
+import (
+	"sync"
+)
+
+// Hub connects Emitters joining it, each event emitted on a member being
+// emitted on the Hub for its own listeners, and on the members joined
+// bidirectionally.
+type Hub struct {
+	// The Emitter the Hub's listeners are added to.
+	*Emitter
+	// Mutex guarding the members.
+	mutex sync.Mutex
+	// Map of member to the function disconnecting it from the Hub.
+	members map[*Emitter]func()
+}
+
+// NewHub returns a new Hub without members.
+func NewHub() *Hub {
+	return &Hub{
+		Emitter: NewEmitter(),
+		members: make(map[*Emitter]func()),
+	}
+}
+
+// Join joins the member to the Hub, forwarding every event emitted on it to
+// the Hub as Forward does. If bidirectional, every event emitted on the
+// Hub, including those forwarded from other members, is forwarded to the
+// member too. Joining a member again replaces its previous connection.
+func (h *Hub) Join(member *Emitter, bidirectional bool) *Hub {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	if leave, ok := h.members[member]; ok {
+		leave()
+	}
+
+	stops := []func(){Forward(member, h.Emitter)}
+
+	if bidirectional {
+		stops = append(stops, Forward(h.Emitter, member))
+	}
+
+	h.members[member] = func() {
+		for _, stop := range stops {
+			stop()
+		}
+	}
+
+	return h
+}
+
+// Leave disconnects the member from the Hub.
+func (h *Hub) Leave(member *Emitter) *Hub {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	if leave, ok := h.members[member]; ok {
+		leave()
+		delete(h.members, member)
+	}
+
+	return h
+}
+
+// Members returns the Emitters joined to the Hub, in no particular order.
+func (h *Hub) Members() []*Emitter {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	members := make([]*Emitter, 0, len(h.members))
+
+	for member := range h.members {
+		members = append(members, member)
+	}
+
+	return members
+}
@@ -0,0 +1,56 @@
+package emission
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestHub(t *testing.T) {
+	hub := NewHub()
+	a, b, c := NewEmitter(), NewEmitter(), NewEmitter()
+	var mutex sync.Mutex
+	received := []string{}
+
+	listener := func(name string) func(string) {
+		return func(s string) {
+			mutex.Lock()
+			received = append(received, name+s)
+			mutex.Unlock()
+		}
+	}
+
+	drain := func() string {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		sort.Strings(received)
+		joined := strings.Join(received, ",")
+		received = received[:0]
+		return joined
+	}
+
+	hub.Join(a, false).Join(b, true).Join(c, true)
+
+	hub.On("test", listener("hub"))
+	a.On("test", listener("a"))
+	b.On("test", listener("b"))
+	c.On("test", listener("c"))
+
+	if a.EmitSync("test", "1"); "a1,b1,c1,hub1" != drain() {
+		t.Error("Failed to connect the members through the Hub.")
+	}
+
+	if b.EmitSync("test", "2"); "b2,c2,hub2" != drain() {
+		t.Error("Failed to forward the event of a bidirectional member once.")
+	}
+
+	if hub.Leave(b).Leave(c); 1 != len(hub.Members()) {
+		t.Error("Failed to list the Hub's members.", hub.Members())
+	}
+
+	if b.EmitSync("test", "3"); "b3" != drain() {
+		t.Error("Failed to disconnect the member leaving the Hub.")
+	}
+}
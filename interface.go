@@ -0,0 +1,54 @@
+package emission
+
+// EventEmitter is the interface of an event emitter, allowing consumers to
+// accept an Emitter without depending on the concrete type and to replace
+// it in tests. The methods return the EventEmitter for chaining.
+type EventEmitter interface {
+	On(event, listener interface{}) EventEmitter
+	Once(event, listener interface{}) EventEmitter
+	Off(event, listener interface{}) EventEmitter
+	Emit(event interface{}, arguments ...interface{}) EventEmitter
+	EmitSync(event interface{}, arguments ...interface{}) EventEmitter
+}
+
+// eventEmitter adapts an Emitter to the EventEmitter interface, whose
+// methods return the interface rather than the concrete Emitter.
+type eventEmitter struct {
+	// emitter is the Emitter the calls are forwarded to.
+	emitter *Emitter
+}
+
+// Interface returns the Emitter as an EventEmitter.
+func (emitter *Emitter) Interface() EventEmitter {
+	return eventEmitter{emitter}
+}
+
+// On forwards to Emitter.On.
+func (adapter eventEmitter) On(event, listener interface{}) EventEmitter {
+	adapter.emitter.On(event, listener)
+	return adapter
+}
+
+// Once forwards to Emitter.Once.
+func (adapter eventEmitter) Once(event, listener interface{}) EventEmitter {
+	adapter.emitter.Once(event, listener)
+	return adapter
+}
+
+// Off forwards to Emitter.Off.
+func (adapter eventEmitter) Off(event, listener interface{}) EventEmitter {
+	adapter.emitter.Off(event, listener)
+	return adapter
+}
+
+// Emit forwards to Emitter.Emit.
+func (adapter eventEmitter) Emit(event interface{}, arguments ...interface{}) EventEmitter {
+	adapter.emitter.Emit(event, arguments...)
+	return adapter
+}
+
+// EmitSync forwards to Emitter.EmitSync.
+func (adapter eventEmitter) EmitSync(event interface{}, arguments ...interface{}) EventEmitter {
+	adapter.emitter.EmitSync(event, arguments...)
+	return adapter
+}
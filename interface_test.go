@@ -0,0 +1,42 @@
+package emission
+
+import (
+	"testing"
+)
+
+type recordingEmitter struct {
+	emitted []interface{}
+}
+
+func (r *recordingEmitter) On(event, listener interface{}) EventEmitter   { return r }
+func (r *recordingEmitter) Once(event, listener interface{}) EventEmitter { return r }
+func (r *recordingEmitter) Off(event, listener interface{}) EventEmitter  { return r }
+
+func (r *recordingEmitter) Emit(event interface{}, arguments ...interface{}) EventEmitter {
+	r.emitted = append(r.emitted, event)
+	return r
+}
+
+func (r *recordingEmitter) EmitSync(event interface{}, arguments ...interface{}) EventEmitter {
+	return r.Emit(event, arguments...)
+}
+
+func TestEventEmitter(t *testing.T) {
+	publish := func(emitter EventEmitter) {
+		emitter.On("test", func() {}).Emit("test").EmitSync("test")
+	}
+
+	recorder := &recordingEmitter{}
+	publish(recorder)
+
+	if 2 != len(recorder.emitted) || "test" != recorder.emitted[0] {
+		t.Error("Failed to emit through the EventEmitter interface.")
+	}
+
+	count := 0
+	publish(NewEmitter().On("test", func() { count++ }).Interface())
+
+	if 2 != count {
+		t.Error("Failed to emit through the EventEmitter interface with an Emitter.")
+	}
+}
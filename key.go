@@ -0,0 +1,40 @@
+package emission
+
+import (
+	"errors"
+	"reflect"
+)
+
+// Error presented when an event cannot be used as a key, such as a slice or
+// a map, or a struct holding one. Methods given such an event panic with
+// ErrInvalidEvent, or call the RecoveryListener with it if one has been set,
+// unless they return errors.
+var ErrInvalidEvent = errors.New("Event is not comparable.")
+
+// checkEvent returns ErrInvalidEvent if the event cannot be used as a key.
+func checkEvent(event interface{}) error {
+	if nil != event && !reflect.ValueOf(event).Comparable() {
+		return ErrInvalidEvent
+	}
+
+	return nil
+}
+
+// rejectEvent reports whether the event cannot be used as a key, in which
+// case it panics with ErrInvalidEvent, or calls the RecoveryListener with it
+// if one has been set. It must be called without the Emitter's mutex held.
+func (emitter *Emitter) rejectEvent(event interface{}) bool {
+	err := checkEvent(event)
+
+	if nil == err {
+		return false
+	}
+
+	if recoverer := emitter.recovery(); nil == recoverer {
+		panic(err)
+	} else {
+		recoverer(event, nil, err)
+	}
+
+	return true
+}
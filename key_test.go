@@ -0,0 +1,82 @@
+package emission
+
+import (
+	"testing"
+	"time"
+)
+
+func TestInvalidEvent(t *testing.T) {
+	var received []error
+
+	emitter := NewEmitter().
+		RecoverWith(func(event, listener interface{}, err error) { received = append(received, err) })
+
+	if err := emitter.TryAddListener([]string{"a"}, func() {}); ErrInvalidEvent != err {
+		t.Error("Failed to reject the uncomparable event.", err)
+	}
+
+	if err := emitter.TryEmit(map[string]int{}); ErrInvalidEvent != err {
+		t.Error("Failed to reject emitting the uncomparable event.", err)
+	}
+
+	emitter.
+		On(struct{ s []int }{}, func() {}).
+		Emit([]int{1})
+
+	if 2 != len(received) || ErrInvalidEvent != received[0] || ErrInvalidEvent != received[1] {
+		t.Error("Failed to call the RecoveryListener with ErrInvalidEvent.", received)
+	}
+
+	if err := emitter.TryAddListener([1]interface{}{"a"}, func() {}); nil != err {
+		t.Error("Rejected the comparable event.", err)
+	}
+}
+
+func TestInvalidEventKeyed(t *testing.T) {
+	var received int
+
+	event := []int{1}
+	emitter := NewEmitter().
+		RecoverWith(func(event, listener interface{}, err error) {
+			if ErrInvalidEvent == err {
+				received++
+			}
+		})
+
+	emitter.
+		EmitSticky(event).
+		RemoveSticky(event).
+		Handle(event, func() {}).
+		RemoveHandler(event).
+		RegisterEvent(event, func() {}).
+		SetDebounce(event, time.Second).
+		SetThrottle(event, time.Second).
+		SetSampling(event, 2).
+		SetCoalescing(event, true).
+		EmitDedup("test", event).
+		RemoveAllListeners(event)
+
+	emitter.GetListenerCount(event)
+	emitter.HasListeners(event)
+
+	if 13 != received {
+		t.Error("Failed to reject each use of the uncomparable event.", received)
+	}
+
+	if _, err := emitter.Call(event); ErrInvalidEvent != err {
+		t.Error("Failed to return ErrInvalidEvent from Call.", err)
+	}
+
+	// The Emitter must not be left locked.
+	emitter.Handle("test", func() {})
+}
+
+func TestInvalidEventPanics(t *testing.T) {
+	defer func() {
+		if ErrInvalidEvent != recover() {
+			t.Error("Failed to panic with ErrInvalidEvent.")
+		}
+	}()
+
+	NewEmitter().EmitSticky([]int{1})
+}
@@ -0,0 +1,17 @@
+package emission
+
+// SetProfilerLabels enables or disables profiler labels, disabled by
+// default. While enabled, listeners called within their own go routine run
+// with the pprof labels "event" and "listener", holding the event as
+// formatted by fmt.Sprint and the name of the listener, so that CPU and
+// go routine profiles attribute time to them. As with pprof.Do, labels set
+// by a go routine which a Dispatcher calls a listener on immediately are
+// cleared once the listener returns.
+func (emitter *Emitter) SetProfilerLabels(enabled bool) *Emitter {
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	emitter.labeled = enabled
+	emitter.publish()
+	return emitter
+}
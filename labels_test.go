@@ -0,0 +1,33 @@
+package emission
+
+import (
+	"bytes"
+	"runtime/pprof"
+	"strings"
+	"testing"
+)
+
+func TestSetProfilerLabels(t *testing.T) {
+	event := "test"
+	started, release := make(chan struct{}), make(chan struct{})
+
+	emitter := NewEmitter().
+		SetProfilerLabels(true).
+		On(event, func() {
+			close(started)
+			<-release
+		}).
+		EmitAsync(event)
+
+	<-started
+
+	var buffer bytes.Buffer
+	pprof.Lookup("goroutine").WriteTo(&buffer, 1)
+
+	close(release)
+	emitter.Wait()
+
+	if !strings.Contains(buffer.String(), `"event":"test"`) {
+		t.Error("Failed to label the listener's go routine with the event.")
+	}
+}
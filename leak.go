@@ -0,0 +1,91 @@
+package emission
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Leak describes a listener suspected of leaking, either because it was
+// added longer ago than a threshold or because its event exceeds the
+// maximum number of listeners.
+type Leak struct {
+	// The event the listener was added for.
+	Event interface{}
+	// Description of the listener, including its call site if captured.
+	Listener ListenerInfo
+	// Time elapsed since the listener was added.
+	Age time.Duration
+	// Whether the listener's event exceeds the maximum number of listeners.
+	Exceeded bool
+}
+
+// Leaks returns the listeners added longer ago than the threshold, unless
+// it is 0 or less, along with every listener of the events exceeding the
+// maximum number of listeners, ordered by event and then by the order they
+// are called. Enabling SetCallSites beforehand reports where each listener
+// was added.
+func (emitter *Emitter) Leaks(threshold time.Duration) []Leak {
+	var leaks []Leak
+
+	now := time.Now()
+
+	emitter.RLock()
+
+	for event, listeners := range emitter.load().events {
+		exceeded := 0 < emitter.maxListeners && len(listeners) > emitter.maxListeners
+
+		for i, l := range listeners {
+			age := now.Sub(l.added)
+
+			if exceeded || 0 < threshold && age > threshold {
+				leaks = append(leaks, Leak{
+					Event:    event,
+					Listener: l.info(i),
+					Age:      age,
+					Exceeded: exceeded,
+				})
+			}
+		}
+	}
+
+	emitter.RUnlock()
+
+	sort.SliceStable(leaks, func(i, j int) bool {
+		a, b := fmt.Sprintf("%#v", leaks[i].Event), fmt.Sprintf("%#v", leaks[j].Event)
+
+		if a != b {
+			return a < b
+		}
+
+		return leaks[i].Listener.Order < leaks[j].Listener.Order
+	})
+
+	return leaks
+}
+
+// ReportLeaks writes the listeners Leaks returns for the threshold to the
+// writer, one per line, including where each was added if captured.
+func (emitter *Emitter) ReportLeaks(writer io.Writer, threshold time.Duration) error {
+	var b strings.Builder
+
+	for _, leak := range emitter.Leaks(threshold) {
+		fmt.Fprintf(&b, "%#v: #%d %s added %s ago", leak.Event, leak.Listener.Handle,
+			leak.Listener.Name, leak.Age.Round(time.Millisecond))
+
+		if leak.Exceeded {
+			b.WriteString(", event exceeds the maximum number of listeners")
+		}
+
+		if "" != leak.Listener.CallSite {
+			fmt.Fprintf(&b, " at %s", leak.Listener.CallSite)
+		}
+
+		b.WriteString("\n")
+	}
+
+	_, err := io.WriteString(writer, b.String())
+	return err
+}
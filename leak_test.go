@@ -0,0 +1,45 @@
+package emission
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLeaks(t *testing.T) {
+	emitter := NewEmitter().
+		SetCallSites(true).
+		SetWarningHandler(func(event interface{}, count int) {}).
+		SetMaxListeners(1).
+		On("old", func() {})
+
+	time.Sleep(20 * time.Millisecond)
+
+	emitter.
+		On("crowded", func() {}).
+		On("crowded", func() {}).
+		On("new", func() {})
+
+	leaks := emitter.Leaks(10 * time.Millisecond)
+
+	if 3 != len(leaks) || "crowded" != leaks[0].Event || "crowded" != leaks[1].Event || "old" != leaks[2].Event {
+		t.Fatal("Failed to report the old listeners and those of crowded events.", leaks)
+	}
+
+	if !leaks[0].Exceeded || leaks[2].Exceeded || 10*time.Millisecond > leaks[2].Age {
+		t.Error("Failed to describe why the listeners are suspected of leaking.", leaks)
+	}
+
+	if 2 != len(emitter.Leaks(0)) {
+		t.Error("Reported old listeners without a threshold.")
+	}
+
+	var buffer bytes.Buffer
+
+	emitter.ReportLeaks(&buffer, 10*time.Millisecond)
+
+	if 3 != strings.Count(buffer.String(), "leak_test.go:") || !strings.Contains(buffer.String(), "exceeds the maximum") {
+		t.Error("Failed to report where the listeners were added.", buffer.String())
+	}
+}
@@ -0,0 +1,141 @@
+package emission
+
+import (
+	"runtime"
+	"time"
+)
+
+// Handle uniquely identifies a listener added to an Emitter. Handles are
+// assigned in increasing order as listeners are added.
+type Handle uint64
+
+// ListenerInfo describes a listener added to an Emitter.
+type ListenerInfo struct {
+	// Handle identifying the listener.
+	Handle Handle
+	// The listener function.
+	Listener interface{}
+	// Name of the listener, defaulting to the name of its function.
+	Name string
+	// Tags the listener was added with.
+	Tags []string
+	// File and line of the call adding the listener, if captured.
+	CallSite string
+	// Time the listener was added.
+	Added time.Time
+	// Priority the listener was added with.
+	Priority int
+	// Position at which the listener is called among the event's listeners.
+	Order int
+	// Whether the listener is removed before it is next called.
+	Once bool
+	// Whether the listener is paused.
+	Paused bool
+	// Number of times the listener may still be called before it is
+	// removed, or 0 if it is never removed.
+	Remaining int
+}
+
+// Listeners returns descriptions of the listeners added for the event, in
+// the order they are called.
+func (emitter *Emitter) Listeners(event interface{}) []ListenerInfo {
+	if emitter.rejectEvent(event) {
+		return []ListenerInfo{}
+	}
+
+	emitter.RLock()
+	defer emitter.RUnlock()
+
+	infos := []ListenerInfo{}
+
+	for i, l := range emitter.load().events[event] {
+		infos = append(infos, l.info(i))
+	}
+
+	return infos
+}
+
+// AddListenerHandle adds the listener as OnWith does, configured by the
+// options, returning the Handle identifying it for use with PauseListener,
+// ResumeListener and the like. As with TryAddListener, an error is returned
+// rather than panicking or calling the RecoveryListener if the listener
+// cannot be added.
+func (emitter *Emitter) AddListenerHandle(event, listener interface{}, options ...ListenerOption) (Handle, error) {
+	record := &listenerRecord{}
+
+	for _, option := range options {
+		option(record)
+	}
+
+	record, err := emitter.add(event, listener, record, false, true)
+
+	if nil != err {
+		return 0, err
+	}
+
+	return record.handle, nil
+}
+
+// PauseListener pauses the listener identified by the handle, as returned
+// by AddListenerHandle, skipping it when its event is emitted until
+// ResumeListener is called. The listener keeps its position among the
+// event's listeners.
+func (emitter *Emitter) PauseListener(handle Handle) *Emitter {
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	if l, ok := emitter.handles[handle]; ok {
+		l.paused.Store(true)
+	}
+
+	return emitter
+}
+
+// ResumeListener resumes the listener identified by the handle, paused
+// by PauseListener or by its circuit breaker tripping.
+func (emitter *Emitter) ResumeListener(handle Handle) *Emitter {
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	if l, ok := emitter.handles[handle]; ok {
+		l.paused.Store(false)
+		l.failures = nil
+	}
+
+	return emitter
+}
+
+// displayName returns the name of the listener, defaulting to the name of
+// its function.
+func (l *listenerRecord) displayName() string {
+	if "" == l.name {
+		if f := runtime.FuncForPC(l.fn.Pointer()); nil != f {
+			return f.Name()
+		}
+	}
+
+	return l.name
+}
+
+// info describes the listener record, called at the position.
+func (l *listenerRecord) info(position int) ListenerInfo {
+	remaining := 0
+
+	if 0 != l.times {
+		remaining = l.times - int(l.calls.Load())
+	}
+
+	return ListenerInfo{
+		Handle:    l.handle,
+		Listener:  l.fn.Interface(),
+		Name:      l.displayName(),
+		Tags:      l.tags,
+		CallSite:  l.site,
+		Added:     l.added,
+		Priority:  l.priority,
+		Order:     position,
+		Once:      1 == l.times-int(l.calls.Load()),
+		Remaining: remaining,
+		Paused:    l.paused.Load(),
+	}
+}
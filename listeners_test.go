@@ -0,0 +1,66 @@
+package emission
+
+import (
+	"strings"
+	"testing"
+)
+
+func namedListener() {}
+
+func TestListeners(t *testing.T) {
+	event := "test"
+
+	infos := NewEmitter().
+		AddListener(event, namedListener).
+		PrependOnceListener(event, func() {}).
+		Listeners(event)
+
+	if 2 != len(infos) {
+		t.Fatal("Failed to describe the event's listeners.")
+	}
+
+	if !infos[0].Once || infos[1].Once || 0 != infos[0].Order || 1 != infos[1].Order {
+		t.Error("Failed to describe the listeners in the order they are called.", infos)
+	}
+
+	if infos[1].Handle >= infos[0].Handle {
+		t.Error("Failed to assign handles in the order listeners were added.", infos)
+	}
+
+	if !strings.HasSuffix(infos[1].Name, "namedListener") {
+		t.Error("Failed to name the listener after its function.", infos[1].Name)
+	}
+}
+
+func TestPauseListener(t *testing.T) {
+	event := "test"
+	invoked := 0
+
+	emitter := NewEmitter()
+	handle, err := emitter.AddListenerHandle(event, func() { invoked = invoked + 1 })
+
+	if nil != err || emitter.Listeners(event)[0].Handle != handle {
+		t.Fatal("Failed to return the Handle of the listener added.", err)
+	}
+
+	emitter.PauseListener(handle).EmitSync(event)
+
+	if 0 != invoked || !emitter.Listeners(event)[0].Paused {
+		t.Error("Called a paused listener.")
+	}
+
+	emitter.ResumeListener(handle).EmitSync(event)
+
+	if 1 != invoked {
+		t.Error("Failed to call a resumed listener.")
+	}
+}
+
+func TestAddListenerHandleClosed(t *testing.T) {
+	emitter := NewEmitter()
+	emitter.Close()
+
+	if handle, err := emitter.AddListenerHandle("test", func() {}); 0 != handle || ErrClosed != err {
+		t.Error("Failed to return the error of adding a listener to a closed emitter.", handle, err)
+	}
+}
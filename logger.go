@@ -0,0 +1,29 @@
+package emission
+
+import (
+	"context"
+	"log/slog"
+)
+
+// SetLogger sets the logger the Emitter logs to. Adding and removing
+// listeners and emitting events are logged at the debug level with the
+// event and listener handle, panics of listeners at the error level and
+// events exceeding the maximum number of listeners at the warning level,
+// instead of writing the warning to the warning writer. Passing nil disables
+// logging, the default.
+func (emitter *Emitter) SetLogger(logger *slog.Logger) *Emitter {
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	emitter.logger = logger
+	emitter.publish()
+	return emitter
+}
+
+// log logs the message and attributes at the level to the Emitter's
+// logger, if any.
+func (emitter *Emitter) log(level slog.Level, msg string, args ...interface{}) {
+	if logger := emitter.load().logger; nil != logger {
+		logger.Log(context.Background(), level, msg, args...)
+	}
+}
@@ -0,0 +1,37 @@
+package emission
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestSetLogger(t *testing.T) {
+	var buffer bytes.Buffer
+
+	event := "test"
+	listener := func() { panic("test") }
+
+	NewEmitter().
+		SetLogger(slog.New(slog.NewTextHandler(&buffer, &slog.HandlerOptions{Level: slog.LevelDebug}))).
+		SetMaxListeners(0).
+		RecoverWith(func(event, listener interface{}, err error) {}).
+		On(event, listener).
+		EmitSync(event).
+		Off(event, listener)
+
+	output := buffer.String()
+
+	for _, msg := range []string{
+		"event has exceeded the maximum number of listeners",
+		"listener added",
+		"event emitted",
+		"listener panicked",
+		"listener removed",
+	} {
+		if !strings.Contains(output, msg) {
+			t.Errorf("Failed to log %q.\n%s", msg, output)
+		}
+	}
+}
@@ -0,0 +1,204 @@
+package emission
+
+import (
+	"context"
+	"sync"
+)
+
+// job is a job queued on a loop.
+type job struct {
+	// Function called by the loop.
+	run func()
+	// Function called instead if the job is dropped from the queue.
+	drop func()
+}
+
+// loop is a go routine calling queued jobs one at a time, in the order
+// they were queued.
+type loop struct {
+	// Mutex guarding the queue.
+	mutex sync.Mutex
+	// Jobs queued to be called.
+	queue []job
+	// Channel signaling the go routine that jobs were queued.
+	wake chan struct{}
+	// Condition signaled when jobs are taken from the queue.
+	space *sync.Cond
+	// Maximum number of jobs queued, if non-zero.
+	limit int
+	// How a job is queued once the queue is full.
+	policy OverflowPolicy
+	// Whether the loop stops once its queue is drained.
+	stopped bool
+}
+
+// newLoop returns a new loop bounded by the limit and policy, starting its
+// go routine.
+func newLoop(limit int, policy OverflowPolicy) *loop {
+	l := &loop{wake: make(chan struct{}, 1), limit: limit, policy: policy}
+	l.space = sync.NewCond(&l.mutex)
+	go l.run()
+	return l
+}
+
+// run calls queued jobs until the loop is stopped and its queue drained.
+func (l *loop) run() {
+	for {
+		l.mutex.Lock()
+		queue, stopped := l.queue, l.stopped
+		l.queue = nil
+		l.space.Broadcast()
+		l.mutex.Unlock()
+
+		for _, j := range queue {
+			j.run()
+		}
+
+		if 0 == len(queue) {
+			if stopped {
+				return
+			}
+
+			<-l.wake
+		}
+	}
+}
+
+// enqueue queues the job to be called by the loop's go routine. Once the
+// queue is full, the job is queued as the loop's OverflowPolicy determines,
+// ErrQueueFull being returned if it is rejected.
+func (l *loop) enqueue(j job) error {
+	var dropped []job
+
+	l.mutex.Lock()
+
+	for 0 < l.limit && len(l.queue) >= l.limit && !l.stopped {
+		switch l.policy {
+		case Block:
+			l.space.Wait()
+			continue
+		case DropOldest:
+			dropped = append(dropped, l.queue[0])
+			l.queue = l.queue[1:]
+			continue
+		}
+
+		l.mutex.Unlock()
+		j.drop()
+
+		if Reject == l.policy {
+			return ErrQueueFull
+		}
+
+		return nil
+	}
+
+	l.queue = append(l.queue, j)
+	l.mutex.Unlock()
+
+	for _, d := range dropped {
+		d.drop()
+	}
+
+	l.signal()
+	return nil
+}
+
+// bound sets the maximum number of jobs queued and how a job is queued once
+// the queue is full.
+func (l *loop) bound(limit int, policy OverflowPolicy) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.limit, l.policy = limit, policy
+	l.space.Broadcast()
+}
+
+// len returns the number of jobs queued.
+func (l *loop) len() int {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	return len(l.queue)
+}
+
+// stop stops the loop once its queue is drained.
+func (l *loop) stop() {
+	l.mutex.Lock()
+	l.stopped = true
+	l.space.Broadcast()
+	l.mutex.Unlock()
+
+	l.signal()
+}
+
+// signal wakes the loop's go routine if it is waiting for jobs.
+func (l *loop) signal() {
+	select {
+	case l.wake <- struct{}{}:
+	default:
+	}
+}
+
+// SetEventLoop enables or disables the Emitter's event loop. While enabled,
+// every emission is queued and processed by a single go routine in the
+// order it was emitted, calling listeners one at a time so that they never
+// run concurrently with each other. Emitting an event then returns as soon
+// as the emission is queued; use Wait to wait for queued emissions to be
+// processed. Disabling the event loop processes the emissions already
+// queued before its go routine exits.
+func (emitter *Emitter) SetEventLoop(enabled bool) *Emitter {
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	if nil != emitter.loop && !enabled {
+		emitter.loop.stop()
+		emitter.loop = nil
+	}
+
+	if nil == emitter.loop && enabled {
+		emitter.loop = newLoop(emitter.queueLimit, emitter.overflow)
+	}
+
+	emitter.publish()
+
+	return emitter
+}
+
+// enqueue queues the emission on the Emitter's event loop if it is
+// enabled, reporting whether it was queued. Queued emissions are counted
+// as in flight until they are processed or dropped. If the emission is
+// rejected by a full queue, enqueue panics with ErrQueueFull or calls the
+// RecoveryListener with it.
+func (emitter *Emitter) enqueue(ctx context.Context, event interface{}, arguments []interface{}, dispatch dispatchFunc) bool {
+	l := emitter.load().loop
+
+	if nil == l {
+		return false
+	}
+
+	emitter.begin()
+
+	err := l.enqueue(job{
+		run: func() {
+			defer emitter.end()
+
+			emitter.deliver(ctx, event, arguments, dispatch)
+		},
+		drop: func() {
+			defer emitter.end()
+
+			discard(ctx)
+		},
+	})
+
+	if nil != err {
+		if recoverer := emitter.recovery(); nil == recoverer {
+			panic(err)
+		} else {
+			recoverer(event, nil, err)
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,39 @@
+package emission
+
+import (
+	"testing"
+)
+
+func TestSetEventLoop(t *testing.T) {
+	emitter := NewEmitter().SetEventLoop(true)
+	received := []int{}
+
+	emitter.
+		AddListener("test", func(value int) {
+			received = append(received, value)
+
+			if 1 == value {
+				emitter.Emit("test", 3)
+			}
+		}).
+		AddListener("test", func(value int) {
+			received = append(received, -value)
+		}).
+		Emit("test", 1).
+		Emit("test", 2).
+		Wait()
+
+	expected := []int{1, -1, 2, -2, 3, -3}
+
+	if len(expected) != len(received) {
+		t.Fatal("Failed to process every emission on the event loop.", received)
+	}
+
+	for i := range expected {
+		if expected[i] != received[i] {
+			t.Error("Failed to process emissions in order on the event loop.", received)
+		}
+	}
+
+	emitter.SetEventLoop(false)
+}
@@ -0,0 +1,106 @@
+package emission
+
+import (
+	"sync"
+)
+
+// mailbox is a bounded queue of calls of a single listener, processed by
+// its own go routine in the order they were posted.
+type mailbox struct {
+	// Mutex held while posting, so that the queue is closed only once no
+	// call is being posted.
+	mutex sync.RWMutex
+	// Queued calls of the listener.
+	jobs chan func()
+	// Closed when the mailbox is closed, releasing calls blocked posting.
+	done chan struct{}
+	// Closes the mailbox once.
+	once sync.Once
+}
+
+// newMailbox returns a new mailbox holding up to size calls, starting its
+// go routine.
+func newMailbox(size int) *mailbox {
+	m := &mailbox{jobs: make(chan func(), size), done: make(chan struct{})}
+
+	go func() {
+		for job := range m.jobs {
+			job()
+		}
+	}()
+
+	return m
+}
+
+// post queues the job, blocking while the mailbox is full. It reports
+// false without queuing the job if the mailbox has been or is closed while
+// blocked.
+func (m *mailbox) post(job func()) bool {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	select {
+	case <-m.done:
+		return false
+	default:
+	}
+
+	select {
+	case m.jobs <- job:
+		return true
+	case <-m.done:
+		return false
+	}
+}
+
+// close closes the mailbox, releasing calls blocked posting to it. Its go
+// routine exits once the calls already queued are processed.
+func (m *mailbox) close() {
+	m.once.Do(func() {
+		close(m.done)
+
+		m.mutex.Lock()
+		close(m.jobs)
+		m.mutex.Unlock()
+	})
+}
+
+// SetMailboxSize enables per-listener mailboxes when size is greater than
+// 0, the default being 0. Listeners which would be called within their own
+// go routine are instead queued in a mailbox holding up to size calls of
+// that listener, processed by a go routine dedicated to it. Each listener
+// then sees events in the order they were emitted and a slow listener never
+// delays the others. Emit returns once the calls are queued rather than
+// once they return, blocking only while a listener's mailbox is full.
+func (emitter *Emitter) SetMailboxSize(size int) *Emitter {
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	emitter.mailboxSize = size
+	emitter.publish()
+	return emitter
+}
+
+// mailboxFor returns the listener's mailbox, creating it if needed, or nil
+// if mailboxes are disabled.
+func (emitter *Emitter) mailboxFor(l *listenerRecord) *mailbox {
+	size := emitter.load().mailboxSize
+
+	if 0 >= size {
+		return nil
+	}
+
+	if m := l.mailbox.Load(); nil != m {
+		return m
+	}
+
+	m := newMailbox(size)
+
+	if !l.mailbox.CompareAndSwap(nil, m) {
+		// Another call created the listener's mailbox first.
+		m.close()
+		return l.mailbox.Load()
+	}
+
+	return m
+}
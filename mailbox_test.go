@@ -0,0 +1,69 @@
+package emission
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSetMailboxSize(t *testing.T) {
+	event := "test"
+	release := make(chan struct{})
+	slow, fast := []int{}, make(chan int, 3)
+
+	emitter := NewEmitter().
+		SetMailboxSize(3).
+		AddListener(event, func(value int) {
+			<-release
+			slow = append(slow, value)
+		}).
+		AddListener(event, func(value int) { fast <- value }).
+		Emit(event, 1).
+		Emit(event, 2).
+		Emit(event, 3)
+
+	for i := 1; i <= 3; i++ {
+		if i != <-fast {
+			t.Error("Failed to call the listener in order while another was slow.")
+		}
+	}
+
+	close(release)
+	emitter.Wait()
+
+	if 3 != len(slow) || 1 != slow[0] || 2 != slow[1] || 3 != slow[2] {
+		t.Error("Failed to call the slow listener in order.", slow)
+	}
+}
+
+func TestMailboxRemoveListenerWhileFull(t *testing.T) {
+	event := "test"
+	release := make(chan struct{})
+
+	emitter := NewEmitter().SetMailboxSize(1)
+
+	listener := func() {
+		<-release
+		emitter.SetMaxListeners(10)
+	}
+
+	emitter.On(event, listener).Emit(event).Emit(event)
+
+	go emitter.Emit(event)
+	time.Sleep(10 * time.Millisecond)
+
+	removed := make(chan struct{})
+
+	go func() {
+		emitter.RemoveListener(event, listener)
+		close(removed)
+	}()
+
+	select {
+	case <-removed:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Failed to remove the listener while its mailbox was full.")
+	}
+
+	close(release)
+	emitter.Wait()
+}
@@ -0,0 +1,74 @@
+package emission
+
+import (
+	"regexp"
+)
+
+// OnMatch adds the listener for every string event matching the pattern,
+// receiving the event as its first argument. The listener can be removed
+// by passing the pattern to RemoveListener, and the pattern is no longer
+// matched once its last listener is removed.
+func (emitter *Emitter) OnMatch(pattern *regexp.Regexp, listener interface{}) *Emitter {
+	emitter.Lock()
+
+	emitter.modify(func(t *table) {
+		for _, p := range t.patterns {
+			if p == pattern {
+				return
+			}
+		}
+
+		t.patterns = append(t.patterns, pattern)
+	})
+
+	emitter.Unlock()
+
+	if nil == emitter.addListener(pattern, listener, &listenerRecord{}, false) {
+		emitter.Lock()
+
+		emitter.modify(func(t *table) {
+			if 0 == len(t.events[pattern]) {
+				t.removePattern(pattern)
+			}
+		})
+
+		emitter.Unlock()
+	}
+
+	return emitter
+}
+
+// removePattern removes the event from the table's patterns if it is one,
+// once its last listener has been removed, so that emissions no longer
+// match it.
+func (t *table) removePattern(event interface{}) {
+	pattern, ok := event.(*regexp.Regexp)
+
+	if !ok {
+		return
+	}
+
+	for i, p := range t.patterns {
+		if p == pattern {
+			t.patterns = append(t.patterns[:i:i], t.patterns[i+1:]...)
+			return
+		}
+	}
+}
+
+// matches returns the patterns added with OnMatch matching the event.
+func (t *table) matches(event interface{}) (patterns []*regexp.Regexp) {
+	name, ok := event.(string)
+
+	if !ok {
+		return
+	}
+
+	for _, pattern := range t.patterns {
+		if pattern.MatchString(name) {
+			patterns = append(patterns, pattern)
+		}
+	}
+
+	return
+}
@@ -0,0 +1,54 @@
+package emission
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestOnMatch(t *testing.T) {
+	received := []interface{}{}
+	listener := func(event interface{}) { received = append(received, event) }
+	pattern := regexp.MustCompile(`^user\.`)
+
+	emitter := NewEmitter().
+		OnMatch(pattern, listener).
+		EmitSync("user.created").
+		EmitSync("order.created")
+
+	if 1 != len(received) || "user.created" != received[0] {
+		t.Error("Failed to call listener for events matching the pattern.", received)
+	}
+
+	emitter.RemoveListener(pattern, listener).EmitSync("user.deleted")
+
+	if 1 != len(received) {
+		t.Error("Failed to remove listener for the pattern.")
+	}
+}
+
+func TestOnMatchRemoved(t *testing.T) {
+	listener := func(event interface{}) {}
+	pattern := regexp.MustCompile(`^user\.`)
+
+	emitter := NewEmitter().
+		OnMatch(pattern, listener).
+		OnMatch(pattern, func(event interface{}) {}).
+		RemoveListener(pattern, listener)
+
+	if 1 != len(emitter.load().patterns) {
+		t.Error("Removed a pattern which still has listeners.")
+	}
+
+	emitter.RemoveAllListeners(pattern)
+
+	if 0 != len(emitter.load().patterns) {
+		t.Error("Failed to remove a pattern once its last listener was removed.")
+	}
+
+	emitter.Close()
+	emitter.RecoverWith(func(event, listener interface{}, err error) {}).OnMatch(pattern, listener)
+
+	if 0 != len(emitter.load().patterns) {
+		t.Error("Kept a pattern whose listener could not be added.")
+	}
+}
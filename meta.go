@@ -0,0 +1,10 @@
+package emission
+
+// EmitWithMeta emits the event with the arguments as Emit does, stamping the
+// emission with the metadata. The metadata is held by the Event of the
+// emission, supplied to ContextMiddleware and listeners through its context
+// and to listeners of type func(Event) in their envelope.
+func (emitter *Emitter) EmitWithMeta(event interface{}, meta map[string]string, arguments ...interface{}) *Emitter {
+	emitter.emit(nil, Event{Name: event, Args: arguments, Metadata: meta}, emitter.parallel)
+	return emitter
+}
@@ -0,0 +1,35 @@
+package emission
+
+import (
+	"context"
+	"testing"
+)
+
+func TestEmitWithMeta(t *testing.T) {
+	var intercepted, received, enveloped string
+
+	NewEmitter().
+		UseContext(func(ctx context.Context, event interface{}, arguments []interface{}, next func([]interface{})) {
+			e, _ := EventFromContext(ctx)
+			intercepted = e.Metadata["tenant"]
+			next(arguments)
+		}).
+		On("test", func(ctx context.Context, s string) {
+			e, _ := EventFromContext(ctx)
+			received = e.Metadata["tenant"] + s
+		}).
+		On("test", func(e Event) { enveloped = e.Metadata["tenant"] }).
+		EmitWithMeta("test", map[string]string{"tenant": "acme"}, "!")
+
+	if "acme" != intercepted {
+		t.Error("Failed to supply the metadata to middleware.", intercepted)
+	}
+
+	if "acme!" != received {
+		t.Error("Failed to supply the metadata to the listener.", received)
+	}
+
+	if "acme" != enveloped {
+		t.Error("Failed to supply the metadata in the envelope.", enveloped)
+	}
+}
@@ -0,0 +1,93 @@
+// Package metrics exports Prometheus metrics about an emission Emitter.
+package metrics
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/chuckpreslar/emission"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Collector is a prometheus.Collector of metrics about an Emitter: the
+// emissions of each event, the duration of listener calls, the panics of
+// listeners and the depth of the Emitter's queue.
+type Collector struct {
+	emitter   *emission.Emitter
+	emits     *prometheus.CounterVec
+	durations *prometheus.HistogramVec
+	panics    *prometheus.CounterVec
+	depth     *prometheus.Desc
+}
+
+// Ensure Collector implements prometheus.Collector and emission.Observer.
+var (
+	_ prometheus.Collector = (*Collector)(nil)
+	_ emission.Observer    = (*Collector)(nil)
+)
+
+// NewCollector returns a new Collector observing the emitter, its metrics
+// being prefixed with the namespace. Events are labeled as formatted by
+// fmt.Sprint, so each distinct event emitted adds a label value: emitters
+// of dynamically named events, such as "user.123.updated", give the metrics
+// unbounded cardinality and should not be collected.
+func NewCollector(emitter *emission.Emitter, namespace string) *Collector {
+	collector := &Collector{
+		emitter: emitter,
+		emits: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "emits_total",
+			Help:      "Number of emissions delivered, by event.",
+		}, []string{"event"}),
+		durations: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "listener_duration_seconds",
+			Help:      "Duration of listener calls, by event.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"event"}),
+		panics: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "listener_panics_total",
+			Help:      "Number of listener calls which panicked, by event.",
+		}, []string{"event"}),
+		depth: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "queue_depth"),
+			"Number of emissions waiting to be delivered.",
+			nil, nil,
+		),
+	}
+
+	emitter.AddObserver(collector)
+	return collector
+}
+
+// Emitted counts an emission of the event.
+func (collector *Collector) Emitted(event interface{}) {
+	collector.emits.WithLabelValues(fmt.Sprint(event)).Inc()
+}
+
+// Called observes the duration of a call of a listener of the event.
+func (collector *Collector) Called(event interface{}, duration time.Duration) {
+	collector.durations.WithLabelValues(fmt.Sprint(event)).Observe(duration.Seconds())
+}
+
+// Panicked counts a panic of a listener of the event.
+func (collector *Collector) Panicked(event interface{}) {
+	collector.panics.WithLabelValues(fmt.Sprint(event)).Inc()
+}
+
+// Describe sends the descriptors of the Collector's metrics to the channel.
+func (collector *Collector) Describe(descs chan<- *prometheus.Desc) {
+	collector.emits.Describe(descs)
+	collector.durations.Describe(descs)
+	collector.panics.Describe(descs)
+	descs <- collector.depth
+}
+
+// Collect sends the Collector's metrics to the channel.
+func (collector *Collector) Collect(metrics chan<- prometheus.Metric) {
+	collector.emits.Collect(metrics)
+	collector.durations.Collect(metrics)
+	collector.panics.Collect(metrics)
+	metrics <- prometheus.MustNewConstMetric(collector.depth, prometheus.GaugeValue, float64(collector.emitter.QueueDepth()))
+}
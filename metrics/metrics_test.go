@@ -0,0 +1,48 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/chuckpreslar/emission"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func TestCollector(t *testing.T) {
+	emitter := emission.NewEmitter()
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(NewCollector(emitter, "test"))
+
+	emitter.
+		RecoverWith(func(event, listener interface{}, err error) {}).
+		On("event", func() { panic("test") }).
+		EmitSync("event")
+
+	families, err := registry.Gather()
+
+	if nil != err {
+		t.Fatal("Failed to gather the metrics.", err)
+	}
+
+	values := map[string]float64{}
+
+	for _, family := range families {
+		for _, metric := range family.GetMetric() {
+			switch {
+			case nil != metric.GetCounter():
+				values[family.GetName()] = metric.GetCounter().GetValue()
+			case nil != metric.GetHistogram():
+				values[family.GetName()] = float64(metric.GetHistogram().GetSampleCount())
+			case nil != metric.GetGauge():
+				values[family.GetName()] = metric.GetGauge().GetValue()
+			}
+		}
+	}
+
+	if 1 != values["test_emits_total"] || 1 != values["test_listener_duration_seconds"] || 1 != values["test_listener_panics_total"] {
+		t.Error("Failed to collect the Emitter's metrics.", values)
+	}
+
+	if _, ok := values["test_queue_depth"]; !ok {
+		t.Error("Failed to collect the Emitter's queue depth.", values)
+	}
+}
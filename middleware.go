@@ -0,0 +1,49 @@
+package emission
+
+import "context"
+
+// Middleware wraps the emission of an event. It is supplied the event, its
+// arguments and a next function which continues the emission with the
+// arguments it is passed. A Middleware may modify the arguments passed to
+// next, act before and after calling it, or not call it at all to prevent
+// the event's listeners from being called.
+type Middleware func(event interface{}, arguments []interface{}, next func([]interface{}))
+
+// ContextMiddleware wraps the emission of an event as Middleware does, and
+// is also supplied the context of the emission, holding its Event.
+type ContextMiddleware func(ctx context.Context, event interface{}, arguments []interface{}, next func([]interface{}))
+
+// Use adds the middleware to wrap every emission of the Emitter. Middleware
+// is applied in the order it was added, the first added being outermost.
+func (emitter *Emitter) Use(middleware Middleware) *Emitter {
+	return emitter.UseContext(func(ctx context.Context, event interface{}, arguments []interface{}, next func([]interface{})) {
+		middleware(event, arguments, next)
+	})
+}
+
+// UseContext adds the middleware to wrap every emission of the Emitter as
+// Use does.
+func (emitter *Emitter) UseContext(middleware ContextMiddleware) *Emitter {
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	emitter.middleware = append(emitter.middleware, middleware)
+	emitter.publish()
+	return emitter
+}
+
+// intercept passes the event and arguments through the table's
+// middleware, ending with a call to dispatch.
+func (t *table) intercept(ctx context.Context, event interface{}, arguments []interface{}, dispatch func([]interface{})) {
+	next := dispatch
+
+	for i := len(t.middleware) - 1; i >= 0; i-- {
+		m, n := t.middleware[i], next
+
+		next = func(arguments []interface{}) {
+			m(ctx, event, arguments, n)
+		}
+	}
+
+	next(arguments)
+}
@@ -0,0 +1,40 @@
+package emission
+
+import (
+	"testing"
+)
+
+func TestUse(t *testing.T) {
+	event := "test"
+	received := []string{}
+
+	NewEmitter().
+		Use(func(event interface{}, arguments []interface{}, next func([]interface{})) {
+			received = append(received, "outer")
+			next(append(arguments, "b"))
+		}).
+		Use(func(event interface{}, arguments []interface{}, next func([]interface{})) {
+			received = append(received, "inner")
+			next(arguments)
+		}).
+		AddListener(event, func(a, b string) { received = append(received, a+b) }).
+		EmitSync(event, "a")
+
+	if 3 != len(received) || "outer" != received[0] || "inner" != received[1] || "ab" != received[2] {
+		t.Error("Failed to apply middleware in order.", received)
+	}
+}
+
+func TestUseShortCircuit(t *testing.T) {
+	event := "test"
+	flag := false
+
+	NewEmitter().
+		Use(func(event interface{}, arguments []interface{}, next func([]interface{})) {}).
+		AddListener(event, func() { flag = true }).
+		Emit(event)
+
+	if flag {
+		t.Error("Middleware failed to prevent the listener from being called.")
+	}
+}
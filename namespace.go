@@ -0,0 +1,45 @@
+package emission
+
+import (
+	"strings"
+)
+
+// SetDelimiter sets the delimiter separating the segments of hierarchical
+// string events. When set, emitting an event such as "user.created" also
+// calls the listeners added for "user" and "user.*", ancestors closest to
+// the event being called first. An empty delimiter, the default, disables
+// hierarchical matching.
+func (emitter *Emitter) SetDelimiter(delimiter string) *Emitter {
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	emitter.modify(func(t *table) {
+		t.delimiter = delimiter
+	})
+
+	return emitter
+}
+
+// ancestors returns the events whose listeners should also be called when
+// the event is emitted, ordered from the closest ancestor to the root.
+func (t *table) ancestors(event interface{}) (events []string) {
+	name, ok := event.(string)
+
+	if !ok || "" == t.delimiter {
+		return
+	}
+
+	segments := strings.Split(name, t.delimiter)
+
+	for i := len(segments) - 1; i > 0; i-- {
+		parent := strings.Join(segments[:i], t.delimiter)
+		events = append(events, parent)
+
+		// An event such as "user.*" is not its own ancestor.
+		if wildcard := parent + t.delimiter + "*"; wildcard != name {
+			events = append(events, wildcard)
+		}
+	}
+
+	return
+}
@@ -0,0 +1,47 @@
+package emission
+
+import (
+	"testing"
+)
+
+func TestSetDelimiter(t *testing.T) {
+	received := []string{}
+
+	NewEmitter().
+		SetDelimiter(".").
+		AddListener("user", func() { received = append(received, "user") }).
+		AddListener("user.*", func() { received = append(received, "user.*") }).
+		AddListener("user.created", func() { received = append(received, "user.created") }).
+		AddListener("user.deleted", func() { received = append(received, "user.deleted") }).
+		EmitSync("user.created")
+
+	if 3 != len(received) || "user.created" != received[0] || "user.*" != received[2] {
+		t.Error("Failed to call listeners of the event's ancestors.", received)
+	}
+}
+
+func TestWithoutDelimiter(t *testing.T) {
+	flag := false
+
+	NewEmitter().
+		AddListener("user", func() { flag = true }).
+		EmitSync("user.created")
+
+	if flag {
+		t.Error("Called listeners of the event's ancestors without a delimiter.")
+	}
+}
+
+func TestDelimiterWildcardEmitted(t *testing.T) {
+	received := []string{}
+
+	NewEmitter().
+		SetDelimiter(".").
+		AddListener("user", func() { received = append(received, "user") }).
+		AddListener("user.*", func() { received = append(received, "user.*") }).
+		EmitSync("user.*")
+
+	if 2 != len(received) || "user.*" != received[0] || "user" != received[1] {
+		t.Error("Failed to call the listeners of a wildcard event emitted once.", received)
+	}
+}
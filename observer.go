@@ -0,0 +1,43 @@
+package emission
+
+import (
+	"time"
+)
+
+// Observer is notified of the Emitter's activity, allowing metrics to be
+// collected about it. Its methods are called synchronously and must be
+// safe for concurrent use.
+type Observer interface {
+	// Emitted is called when an emission of the event is delivered to
+	// its listeners.
+	Emitted(event interface{})
+	// Called is called when a call of a listener of the event returns,
+	// with the duration of the call.
+	Called(event interface{}, duration time.Duration)
+	// Panicked is called when a listener of the event panics.
+	Panicked(event interface{})
+}
+
+// AddObserver adds the Observer to be notified of the Emitter's activity.
+func (emitter *Emitter) AddObserver(observer Observer) *Emitter {
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	emitter.observers = append(emitter.observers, observer)
+	emitter.publish()
+	return emitter
+}
+
+// QueueDepth returns the number of emissions waiting to be delivered, either
+// buffered while the Emitter is paused or queued by its event loop.
+func (emitter *Emitter) QueueDepth() int {
+	emitter.RLock()
+	depth, l := len(emitter.buffered), emitter.loop
+	emitter.RUnlock()
+
+	if nil != l {
+		depth += l.len()
+	}
+
+	return depth
+}
@@ -0,0 +1,59 @@
+package emission
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+type countingObserver struct {
+	sync.Mutex
+	emitted, called, panicked int
+}
+
+func (o *countingObserver) Emitted(event interface{}) {
+	o.Lock()
+	o.emitted++
+	o.Unlock()
+}
+
+func (o *countingObserver) Called(event interface{}, duration time.Duration) {
+	o.Lock()
+	o.called++
+	o.Unlock()
+}
+
+func (o *countingObserver) Panicked(event interface{}) {
+	o.Lock()
+	o.panicked++
+	o.Unlock()
+}
+
+func TestAddObserver(t *testing.T) {
+	event := "test"
+	observer := &countingObserver{}
+
+	NewEmitter().
+		AddObserver(observer).
+		RecoverWith(func(event, listener interface{}, err error) {}).
+		On(event, func() {}).
+		On(event, func() { panic("test") }).
+		Emit(event).
+		EmitSync(event)
+
+	if 2 != observer.emitted || 4 != observer.called || 2 != observer.panicked {
+		t.Error("Failed to notify the observer.", observer.emitted, observer.called, observer.panicked)
+	}
+}
+
+func TestQueueDepth(t *testing.T) {
+	emitter := NewEmitter().Pause().Emit("test").Emit("test")
+
+	if 2 != emitter.QueueDepth() {
+		t.Error("Failed to count the buffered emissions.", emitter.QueueDepth())
+	}
+
+	if 0 != emitter.Resume().QueueDepth() {
+		t.Error("Failed to count the emissions once delivered.")
+	}
+}
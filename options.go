@@ -0,0 +1,34 @@
+package emission
+
+// ListenerOption configures a listener added with OnWith.
+type ListenerOption func(*listenerRecord)
+
+// WithEventArg passes the emitted event to the listener ahead of the
+// arguments, as listeners of Any receive it, so that a listener added for
+// several events can tell which was emitted.
+func WithEventArg() ListenerOption {
+	return func(l *listenerRecord) {
+		l.withEvent = true
+	}
+}
+
+// WithName names the listener, the name describing it in place of the name
+// of its function in ListenerInfo, ListenerStats, warnings, logs and the
+// PanicError supplied to the RecoveryListener.
+func WithName(name string) ListenerOption {
+	return func(l *listenerRecord) {
+		l.name = name
+	}
+}
+
+// OnWith adds the listener as AddListener does, configured by the options.
+func (emitter *Emitter) OnWith(event, listener interface{}, options ...ListenerOption) *Emitter {
+	record := &listenerRecord{}
+
+	for _, option := range options {
+		option(record)
+	}
+
+	emitter.addListener(event, listener, record, false)
+	return emitter
+}
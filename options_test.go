@@ -0,0 +1,56 @@
+package emission
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestWithEventArg(t *testing.T) {
+	received := []interface{}{}
+
+	listener := func(event interface{}, n int) {
+		received = append(received, event, n)
+	}
+
+	NewEmitter().
+		OnWith("a", listener, WithEventArg()).
+		OnWith("b", listener, WithEventArg()).
+		EmitSync("a", 1).
+		EmitSync("b", 2)
+
+	if 4 != len(received) || "a" != received[0] || 1 != received[1] || "b" != received[2] || 2 != received[3] {
+		t.Error("Failed to pass the event ahead of the arguments.", received)
+	}
+}
+
+func TestWithName(t *testing.T) {
+	var (
+		buffer   bytes.Buffer
+		received error
+	)
+
+	emitter := NewEmitter().
+		SetMaxListeners(1).
+		SetWarningWriter(&buffer).
+		RecoverWith(func(event, listener interface{}, err error) { received = err }).
+		OnWith("test", func() {}, WithName("first")).
+		OnWith("test", func() { panic("failure") }, WithName("second")).
+		EmitSync("test")
+
+	if infos := emitter.Listeners("test"); "first" != infos[0].Name || "second" != infos[1].Name {
+		t.Error("Failed to describe the listeners by name.", infos)
+	}
+
+	if stats := emitter.Stats("test"); "second" != stats[1].Name {
+		t.Error("Failed to describe the listener's stats by name.", stats)
+	}
+
+	if err, ok := received.(*PanicError); !ok || "second" != err.Listener {
+		t.Error("Failed to name the panicking listener.", received)
+	}
+
+	if !strings.Contains(buffer.String(), "adding listener `second`") {
+		t.Error("Failed to name the listener in the warning.", buffer.String())
+	}
+}
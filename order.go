@@ -0,0 +1,31 @@
+package emission
+
+// Ordering determines the order in which Emit starts the listeners it calls
+// within their own go routines.
+type Ordering int
+
+const (
+	// Unordered starts listeners without regard to order. It is the
+	// Emitter's default Ordering.
+	Unordered Ordering = iota
+	// Ordered starts each listener only once the listener before it has
+	// started running, listeners still running concurrently.
+	Ordered
+	// Serialized starts each listener only once the listener before it
+	// has returned, calling them one at a time in order.
+	Serialized
+)
+
+// SetOrdering sets the Ordering with which Emit starts listeners, in the
+// order EmitSync would call them. Unlike EmitSync, listeners are still
+// called within their own go routines and separate emissions still run
+// concurrently, making the order of calls reproducible for tests and
+// debugging. It has no effect while mailboxes are enabled.
+func (emitter *Emitter) SetOrdering(ordering Ordering) *Emitter {
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	emitter.ordering = ordering
+	emitter.publish()
+	return emitter
+}
@@ -0,0 +1,41 @@
+package emission
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestSetOrdering(t *testing.T) {
+	for _, ordering := range []Ordering{Ordered, Serialized} {
+		var mutex sync.Mutex
+
+		event := "test"
+		received := []int{}
+		emitter := NewEmitter().SetMaxListeners(-1).SetOrdering(ordering)
+
+		for i := 0; i < 20; i++ {
+			i := i
+
+			emitter.On(event, func() {
+				mutex.Lock()
+				received = append(received, i)
+				mutex.Unlock()
+			})
+		}
+
+		emitter.Emit(event)
+
+		if 20 != len(received) {
+			t.Fatal("Failed to call every listener.", received)
+		}
+
+		if Serialized == ordering {
+			for i, value := range received {
+				if i != value {
+					t.Error("Failed to call listeners in order.", received)
+					break
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package emission
+
+import (
+	"fmt"
+)
+
+// PanicError is the error supplied to the RecoveryListener when a listener
+// panics, holding the value it panicked with and the stack trace of the go
+// routine at the time of the panic.
+type PanicError struct {
+	// Value the listener panicked with.
+	Value interface{}
+	// Name of the listener, defaulting to the name of its function.
+	Listener string
+	// Stack trace of the panicking go routine, as formatted by
+	// debug.Stack.
+	Stack []byte
+}
+
+// Error formats the value the listener panicked with.
+func (err *PanicError) Error() string {
+	return fmt.Sprintf("%v", err.Value)
+}
+
+// Unwrap returns the value the listener panicked with if it is an error, so
+// that errors.Is and errors.As find the original error.
+func (err *PanicError) Unwrap() error {
+	original, _ := err.Value.(error)
+	return original
+}
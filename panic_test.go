@@ -0,0 +1,26 @@
+package emission
+
+import (
+	"errors"
+	"io"
+	"testing"
+)
+
+func TestPanicErrorUnwrap(t *testing.T) {
+	var recovered error
+
+	NewEmitter().
+		RecoverWith(func(event, listener interface{}, err error) { recovered = err }).
+		On("test", func() { panic(io.EOF) }).
+		EmitSync("test")
+
+	var panicked *PanicError
+
+	if !errors.Is(recovered, io.EOF) || !errors.As(recovered, &panicked) || io.EOF != panicked.Value {
+		t.Error("Failed to preserve the original panic value.", recovered)
+	}
+
+	if nil != (&PanicError{Value: "test"}).Unwrap() {
+		t.Error("Unwrapped a panic value which is not an error.")
+	}
+}
@@ -0,0 +1,67 @@
+package emission
+
+import "context"
+
+// emission is an emission buffered while the Emitter is paused.
+type emission struct {
+	ctx       context.Context
+	event     interface{}
+	arguments []interface{}
+	dispatch  dispatchFunc
+}
+
+// Pause pauses the Emitter, buffering emitted events instead of calling
+// their listeners until Resume is called.
+func (emitter *Emitter) Pause() *Emitter {
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	emitter.paused = true
+	emitter.publish()
+	return emitter
+}
+
+// Resume resumes the Emitter, emitting the events buffered while it was
+// paused in the order they were emitted before returning.
+func (emitter *Emitter) Resume() *Emitter {
+	for {
+		emitter.Lock()
+		buffered := emitter.buffered
+		emitter.buffered = nil
+
+		// Remain paused until the buffer is drained so that events
+		// emitted while flushing are emitted after those buffered.
+		if 0 == len(buffered) {
+			emitter.paused = false
+			emitter.publish()
+			emitter.Unlock()
+			return emitter
+		}
+
+		emitter.Unlock()
+
+		for _, e := range buffered {
+			if !emitter.enqueue(e.ctx, e.event, e.arguments, e.dispatch) {
+				emitter.deliver(e.ctx, e.event, e.arguments, e.dispatch)
+			}
+		}
+	}
+}
+
+// buffer buffers the emission if the Emitter is paused, reporting whether
+// it was buffered.
+func (emitter *Emitter) buffer(ctx context.Context, event interface{}, arguments []interface{}, dispatch dispatchFunc) bool {
+	if !emitter.load().paused {
+		return false
+	}
+
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	if emitter.paused {
+		emitter.buffered = append(emitter.buffered, emission{ctx, event, arguments, dispatch})
+		return true
+	}
+
+	return false
+}
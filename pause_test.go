@@ -0,0 +1,26 @@
+package emission
+
+import (
+	"testing"
+)
+
+func TestPause(t *testing.T) {
+	event := "test"
+	received := []int{}
+
+	emitter := NewEmitter().
+		Pause().
+		EmitSync(event, 1).
+		AddListener(event, func(value int) { received = append(received, value) }).
+		EmitSync(event, 2)
+
+	if 0 != len(received) {
+		t.Error("Called listeners while the emitter was paused.", received)
+	}
+
+	emitter.Resume().EmitSync(event, 3)
+
+	if 3 != len(received) || 1 != received[0] || 2 != received[1] || 3 != received[2] {
+		t.Error("Failed to emit the buffered events in order when resumed.", received)
+	}
+}
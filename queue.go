@@ -0,0 +1,48 @@
+package emission
+
+import (
+	"errors"
+)
+
+// Error presented when an emission is rejected by the Emitter's full event
+// loop queue.
+var ErrQueueFull = errors.New("Event loop queue is full.")
+
+// OverflowPolicy determines how an emission is queued on the Emitter's
+// event loop once its queue is full.
+type OverflowPolicy int
+
+const (
+	// Block waits for room in the queue. It is the Emitter's default
+	// OverflowPolicy. A listener emitting an event while the queue is full
+	// blocks the event loop indefinitely.
+	Block OverflowPolicy = iota
+	// DropOldest drops the oldest emission queued to make room.
+	DropOldest
+	// DropNewest drops the emission.
+	DropNewest
+	// Reject drops the emission, panicking with ErrQueueFull or calling the
+	// RecoveryListener with it if one has been set.
+	Reject
+)
+
+// SetQueueLimit bounds the number of emissions queued on the Emitter's
+// event loop, waiting to be processed, to the limit. Once the queue is
+// full, emissions are queued as the policy determines. A limit of 0 or
+// less leaves the queue unbounded.
+func (emitter *Emitter) SetQueueLimit(limit int, policy OverflowPolicy) *Emitter {
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	if 0 > limit {
+		limit = 0
+	}
+
+	emitter.queueLimit, emitter.overflow = limit, policy
+
+	if nil != emitter.loop {
+		emitter.loop.bound(limit, policy)
+	}
+
+	return emitter
+}
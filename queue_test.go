@@ -0,0 +1,73 @@
+package emission
+
+import (
+	"sync"
+	"testing"
+)
+
+func testQueueLimit(t *testing.T, policy OverflowPolicy, expected []int) error {
+	event := "test"
+	started := make(chan struct{})
+	proceed := make(chan struct{})
+	var (
+		mutex    sync.Mutex
+		received []int
+		rejected error
+	)
+
+	emitter := NewEmitter().
+		SetEventLoop(true).
+		SetQueueLimit(1, policy).
+		RecoverWith(func(event, listener interface{}, err error) { rejected = err }).
+		AddListener(event, func(n int) {
+			if 1 == n {
+				close(started)
+				<-proceed
+			}
+
+			mutex.Lock()
+			received = append(received, n)
+			mutex.Unlock()
+		})
+
+	emitter.Emit(event, 1)
+	<-started
+
+	emitted := make(chan struct{})
+
+	go func() {
+		emitter.Emit(event, 2).Emit(event, 3)
+		close(emitted)
+	}()
+
+	if Block != policy {
+		<-emitted
+	}
+
+	close(proceed)
+	<-emitted
+	emitter.Wait()
+
+	if len(expected) != len(received) {
+		t.Fatal("Failed to apply the OverflowPolicy.", policy, received)
+	}
+
+	for i := range expected {
+		if expected[i] != received[i] {
+			t.Error("Failed to apply the OverflowPolicy.", policy, received)
+		}
+	}
+
+	emitter.SetEventLoop(false)
+	return rejected
+}
+
+func TestSetQueueLimit(t *testing.T) {
+	testQueueLimit(t, Block, []int{1, 2, 3})
+	testQueueLimit(t, DropOldest, []int{1, 3})
+	testQueueLimit(t, DropNewest, []int{1, 2})
+
+	if err := testQueueLimit(t, Reject, []int{1, 2}); ErrQueueFull != err {
+		t.Error("Failed to reject the emission with ErrQueueFull.", err)
+	}
+}
@@ -0,0 +1,110 @@
+package emission
+
+import (
+	"context"
+	"log/slog"
+	"math/rand"
+	"time"
+)
+
+// RetryPolicy determines how failed listener calls are redelivered. A call
+// fails when the listener panics or returns a non-nil error as its last
+// return value.
+type RetryPolicy struct {
+	// Maximum number of attempts at each call, including the first.
+	MaxAttempts int
+	// Delay before the first redelivery, doubled for each one after it.
+	Backoff time.Duration
+	// Maximum delay before a redelivery, if greater than 0.
+	MaxBackoff time.Duration
+	// Fraction of the delay, between 0 and 1, added to it at random.
+	Jitter float64
+	// Optional function called with the event, listener, arguments and
+	// error of a call which failed its last attempt.
+	DeadLetter func(event, listener interface{}, arguments []interface{}, err error)
+}
+
+// SetRetryPolicy sets the RetryPolicy with which failed listener calls are
+// redelivered, in the background, after an exponential backoff. Calls which
+// still fail after the policy's maximum attempts are passed to its
+// DeadLetter function, or if it is nil are handled as they would be without
+// a RetryPolicy. Redeliveries are counted as in flight until their last
+// attempt, and are dropped once the Emitter is closed or the listener is
+// paused, by PauseListener or its circuit breaker, or removed other than
+// by having been called as many times as it was added for. Panics are only
+// redelivered if the policy has a DeadLetter function or a RecoveryListener
+// has been set, so that a last attempt never panics in the background;
+// otherwise they occur within the emitting call as without a RetryPolicy.
+// Passing nil disables redelivery, the default.
+func (emitter *Emitter) SetRetryPolicy(policy *RetryPolicy) *Emitter {
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	emitter.retry = policy
+	emitter.publish()
+	return emitter
+}
+
+// redeliver schedules the next attempt at the listener call which failed
+// with err, or passes it to the policy's DeadLetter function if it was the
+// last attempt. It reports false if the failure is left unhandled.
+func (emitter *Emitter) redeliver(policy *RetryPolicy, ctx context.Context, event interface{}, l *listenerRecord, arguments []interface{}, attempt int, err error) bool {
+	if attempt >= policy.MaxAttempts {
+		if nil == policy.DeadLetter {
+			return false
+		}
+
+		policy.DeadLetter(event, l.fn.Interface(), arguments, err)
+		return true
+	}
+
+	emitter.begin()
+
+	time.AfterFunc(policy.backoff(attempt), func() {
+		defer emitter.end()
+
+		// A RecoveryListener removed since the call was redelivered leaves
+		// its last attempt unhandled, which must not crash the program.
+		defer func() {
+			if r := recover(); nil != r {
+				emitter.log(slog.LevelError, "redelivered listener panicked", "event", event, "handle", l.handle, "listener", l.displayName(), "panic", r)
+			}
+		}()
+
+		if !emitter.isClosed() && emitter.retrying(l) {
+			emitter.invoke(ctx, event, l, arguments, attempt+1)
+		}
+	})
+
+	return true
+}
+
+// retrying reports whether failed calls of the listener may still be
+// redelivered: it must not be paused, nor removed other than by having been
+// called as many times as it was added for.
+func (emitter *Emitter) retrying(l *listenerRecord) bool {
+	if l.paused.Load() {
+		return false
+	}
+
+	emitter.RLock()
+	record, ok := emitter.handles[l.handle]
+	emitter.RUnlock()
+
+	return ok && l == record || 0 != l.times && l.calls.Load() >= int64(l.times)
+}
+
+// backoff returns the delay before redelivering a call after the attempt.
+func (policy *RetryPolicy) backoff(attempt int) time.Duration {
+	delay := policy.Backoff
+
+	for i := 1; i < attempt && (0 >= policy.MaxBackoff || delay < policy.MaxBackoff); i++ {
+		delay *= 2
+	}
+
+	if 0 < policy.MaxBackoff && delay > policy.MaxBackoff {
+		delay = policy.MaxBackoff
+	}
+
+	return delay + time.Duration(policy.Jitter*rand.Float64()*float64(delay))
+}
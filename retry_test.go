@@ -0,0 +1,107 @@
+package emission
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSetRetryPolicy(t *testing.T) {
+	event := "test"
+	calls := 0
+	failure := errors.New("test")
+
+	var dead error
+
+	emitter := NewEmitter().
+		SetRetryPolicy(&RetryPolicy{
+			MaxAttempts: 3,
+			Backoff:     time.Millisecond,
+			Jitter:      0.5,
+			DeadLetter: func(event, listener interface{}, arguments []interface{}, err error) {
+				dead = err
+			},
+		}).
+		On(event, func(n int) error {
+			calls++
+			return failure
+		})
+
+	emitter.EmitSync(event, 1)
+	emitter.Wait()
+
+	if 3 != calls || failure != dead {
+		t.Error("Failed to redeliver the call until its last attempt.", calls, dead)
+	}
+
+	calls = 0
+	emitter.RemoveAllListeners(event).On(event, func() {
+		calls++
+
+		if 2 > calls {
+			panic("test")
+		}
+	})
+
+	emitter.EmitSync(event)
+	emitter.Wait()
+
+	if 2 != calls {
+		t.Error("Failed to redeliver the call after a panic.", calls)
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := &RetryPolicy{Backoff: time.Second, MaxBackoff: 5 * time.Second}
+
+	for attempt, expected := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second} {
+		if delay := policy.backoff(attempt + 1); expected != delay {
+			t.Error("Failed to back off exponentially.", attempt+1, delay)
+		}
+	}
+}
+
+func TestRetryPolicyRemoved(t *testing.T) {
+	event := "test"
+	calls := 0
+	failure := errors.New("test")
+
+	emitter := NewEmitter().
+		SetRetryPolicy(&RetryPolicy{MaxAttempts: 3, Backoff: 10 * time.Millisecond})
+
+	listener := func() error {
+		calls++
+		return failure
+	}
+
+	emitter.On(event, listener).EmitSync(event).RemoveListener(event, listener)
+	emitter.Wait()
+
+	handle, _ := emitter.AddListenerHandle(event, listener)
+	emitter.EmitSync(event).PauseListener(handle)
+	emitter.Wait()
+
+	if 2 != calls {
+		t.Error("Redelivered the call of a removed or paused listener.", calls)
+	}
+}
+
+func TestRetryPolicyUnrecovered(t *testing.T) {
+	event := "test"
+	calls := 0
+
+	emitter := NewEmitter().
+		SetRetryPolicy(&RetryPolicy{MaxAttempts: 3, Backoff: time.Millisecond}).
+		On(event, func() {
+			calls++
+			panic("test")
+		})
+
+	defer func() {
+		if "test" != recover() || 1 != calls {
+			t.Error("Failed to panic within the emitting call without a DeadLetter or RecoveryListener.", calls)
+		}
+	}()
+
+	emitter.EmitSync(event)
+}
@@ -0,0 +1,77 @@
+package emission
+
+import (
+	"math/rand"
+	"sync/atomic"
+)
+
+// sampler determines which emissions of a sampled event are released.
+type sampler struct {
+	// Release one emission of every, if non-zero.
+	every uint64
+	// Probability with which an emission is released, if every is zero.
+	rate float64
+	// Number of emissions sampled.
+	count atomic.Uint64
+}
+
+// SetSampling samples the event, releasing the first of every emissions of
+// it and dropping the others. Sampling an event every 1 or fewer emissions
+// stops sampling it.
+func (emitter *Emitter) SetSampling(event interface{}, every int) *Emitter {
+	if 1 >= every {
+		return emitter.setSampler(event, nil)
+	}
+
+	return emitter.setSampler(event, &sampler{every: uint64(every)})
+}
+
+// SetSampleRate samples the event, releasing each emission of it with the
+// probability rate and dropping the others. Sampling an event at a rate of
+// 1 or more stops sampling it.
+func (emitter *Emitter) SetSampleRate(event interface{}, rate float64) *Emitter {
+	if 1 <= rate {
+		return emitter.setSampler(event, nil)
+	}
+
+	return emitter.setSampler(event, &sampler{rate: rate})
+}
+
+// setSampler sets the event's sampler, or stops sampling it if nil.
+func (emitter *Emitter) setSampler(event interface{}, s *sampler) *Emitter {
+	if emitter.rejectEvent(event) {
+		return emitter
+	}
+
+	emitter.Lock()
+	defer emitter.Unlock()
+	defer emitter.publish()
+
+	if nil == s {
+		delete(emitter.samplers, event)
+		return emitter
+	}
+
+	if nil == emitter.samplers {
+		emitter.samplers = make(map[interface{}]*sampler)
+	}
+
+	emitter.samplers[event] = s
+	return emitter
+}
+
+// sample reports whether an emission of the event is released by its
+// sampler, if sampled.
+func (emitter *Emitter) sample(event interface{}) bool {
+	s := emitter.load().samplers[event]
+
+	if nil == s {
+		return true
+	}
+
+	if 0 != s.every {
+		return 1 == s.count.Add(1)%s.every
+	}
+
+	return rand.Float64() < s.rate
+}
@@ -0,0 +1,51 @@
+package emission
+
+import (
+	"testing"
+)
+
+func TestSetSampling(t *testing.T) {
+	event := "test"
+	received := []int{}
+
+	emitter := NewEmitter().
+		SetSampling(event, 3).
+		AddListener(event, func(n int) { received = append(received, n) })
+
+	for n := 1; n <= 7; n++ {
+		emitter.EmitSync(event, n)
+	}
+
+	if 3 != len(received) || 1 != received[0] || 4 != received[1] || 7 != received[2] {
+		t.Error("Failed to release one of every emissions.", received)
+	}
+
+	emitter.SetSampling(event, 1).EmitSync(event, 8)
+
+	if 4 != len(received) {
+		t.Error("Failed to stop sampling the event.", received)
+	}
+}
+
+func TestSetSampleRate(t *testing.T) {
+	event := "test"
+	received := 0
+
+	emitter := NewEmitter().
+		SetSampleRate(event, 0).
+		AddListener(event, func() { received = received + 1 })
+
+	for i := 0; i < 10; i++ {
+		emitter.EmitSync(event)
+	}
+
+	if 0 != received {
+		t.Error("Failed to drop emissions sampled at a rate of 0.", received)
+	}
+
+	emitter.SetSampleRate(event, 1).EmitSync(event)
+
+	if 1 != received {
+		t.Error("Failed to stop sampling the event.", received)
+	}
+}
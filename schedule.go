@@ -0,0 +1,247 @@
+package emission
+
+import "time"
+
+// schedule is an emission scheduled by ScheduleEmit or ScheduleEmitAt.
+type schedule struct {
+	// The event to emit and the arguments to emit it with.
+	event     interface{}
+	arguments []interface{}
+	// Optional cron expression the event is emitted on, else the event is
+	// emitted once.
+	cron *cron
+	// Time of the next emission.
+	next time.Time
+}
+
+// scheduler is a go routine emitting the Emitter's scheduled events.
+type scheduler struct {
+	// Channel signaled when the schedules are modified.
+	wake chan struct{}
+	// Channel closed to stop the scheduler.
+	quit chan struct{}
+}
+
+// ScheduleEmit schedules the event to be emitted with the arguments as
+// EmitAsync does at each time matched by the cron expression, of the form
+// "minute hour day-of-month month day-of-week", in the local time zone.
+// Scheduled events are only emitted while the Emitter's scheduler is
+// started by StartScheduler. If the expression is invalid then
+// ScheduleEmit panics with ErrInvalidSchedule. If a RecoveryListener has
+// been set then it is called recovering from the panic.
+func (emitter *Emitter) ScheduleEmit(expression string, event interface{}, arguments ...interface{}) *Emitter {
+	if emitter.rejectEvent(event) {
+		return emitter
+	}
+
+	c, err := parseCron(expression)
+
+	if nil != err {
+		if recoverer := emitter.recovery(); nil == recoverer {
+			panic(err)
+		} else {
+			recoverer(event, nil, err)
+			return emitter
+		}
+	}
+
+	emitter.schedule(&schedule{
+		event:     event,
+		arguments: arguments,
+		cron:      c,
+		next:      c.next(time.Now()),
+	})
+
+	return emitter
+}
+
+// ScheduleEmitAt schedules the event to be emitted once with the arguments
+// as EmitAsync does at the time, or as soon as the Emitter's scheduler is
+// started if it has passed.
+func (emitter *Emitter) ScheduleEmitAt(at time.Time, event interface{}, arguments ...interface{}) *Emitter {
+	if emitter.rejectEvent(event) {
+		return emitter
+	}
+
+	emitter.schedule(&schedule{
+		event:     event,
+		arguments: arguments,
+		next:      at,
+	})
+
+	return emitter
+}
+
+// Unschedule removes every scheduled emission of the event.
+func (emitter *Emitter) Unschedule(event interface{}) *Emitter {
+	if emitter.rejectEvent(event) {
+		return emitter
+	}
+
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	var kept []*schedule
+
+	for _, s := range emitter.schedules {
+		if event != s.event {
+			kept = append(kept, s)
+		}
+	}
+
+	emitter.schedules = kept
+	emitter.signalScheduler()
+	return emitter
+}
+
+// StartScheduler starts emitting the Emitter's scheduled events within a go
+// routine of its own, until StopScheduler is called or the Emitter is
+// closed. Emissions scheduled by cron expression while the scheduler was
+// stopped are skipped.
+func (emitter *Emitter) StartScheduler() *Emitter {
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	if nil != emitter.scheduler || emitter.closed {
+		return emitter
+	}
+
+	now := time.Now()
+
+	for _, s := range emitter.schedules {
+		if nil != s.cron {
+			s.next = s.cron.next(now)
+		}
+	}
+
+	s := &scheduler{
+		wake: make(chan struct{}, 1),
+		quit: make(chan struct{}),
+	}
+
+	emitter.scheduler = s
+	go emitter.runScheduler(s)
+	return emitter
+}
+
+// StopScheduler stops emitting the Emitter's scheduled events, which are
+// kept until the scheduler is started again.
+func (emitter *Emitter) StopScheduler() *Emitter {
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	emitter.stopScheduler()
+	return emitter
+}
+
+// stopScheduler stops the Emitter's scheduler, if started. It must be
+// called with the Emitter's mutex held.
+func (emitter *Emitter) stopScheduler() {
+	if nil != emitter.scheduler {
+		close(emitter.scheduler.quit)
+		emitter.scheduler = nil
+	}
+}
+
+// schedule adds the scheduled emission, waking the scheduler to account
+// for it.
+func (emitter *Emitter) schedule(s *schedule) {
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	if s.next.IsZero() {
+		return
+	}
+
+	emitter.schedules = append(emitter.schedules, s)
+	emitter.signalScheduler()
+}
+
+// signalScheduler wakes the Emitter's scheduler, if started, to account for
+// modified schedules. It must be called with the Emitter's mutex held.
+func (emitter *Emitter) signalScheduler() {
+	if nil == emitter.scheduler {
+		return
+	}
+
+	select {
+	case emitter.scheduler.wake <- struct{}{}:
+	default:
+	}
+}
+
+// runScheduler emits the scheduled events as they become due, until the
+// scheduler is stopped.
+func (emitter *Emitter) runScheduler(s *scheduler) {
+	for {
+		var (
+			due  []*schedule
+			kept []*schedule
+			next time.Time
+		)
+
+		emitter.Lock()
+
+		if s != emitter.scheduler {
+			emitter.Unlock()
+			return
+		}
+
+		now := time.Now()
+
+		for _, scheduled := range emitter.schedules {
+			if !scheduled.next.After(now) {
+				due = append(due, scheduled)
+
+				if nil == scheduled.cron {
+					continue
+				}
+
+				scheduled.next = scheduled.cron.next(now)
+
+				if scheduled.next.IsZero() {
+					continue
+				}
+			}
+
+			kept = append(kept, scheduled)
+
+			if next.IsZero() || scheduled.next.Before(next) {
+				next = scheduled.next
+			}
+		}
+
+		emitter.schedules = kept
+		emitter.Unlock()
+
+		for _, scheduled := range due {
+			emitter.EmitAsync(scheduled.event, scheduled.arguments...)
+		}
+
+		var (
+			timer *time.Timer
+			fired <-chan time.Time
+		)
+
+		if !next.IsZero() {
+			timer = time.NewTimer(time.Until(next))
+			fired = timer.C
+		}
+
+		select {
+		case <-fired:
+		case <-s.wake:
+		case <-s.quit:
+		}
+
+		if nil != timer {
+			timer.Stop()
+		}
+
+		select {
+		case <-s.quit:
+			return
+		default:
+		}
+	}
+}
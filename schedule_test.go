@@ -0,0 +1,65 @@
+package emission
+
+import (
+	"testing"
+	"time"
+)
+
+func TestScheduleEmitAt(t *testing.T) {
+	event := "test"
+	received := make(chan int, 1)
+
+	emitter := NewEmitter().
+		AddListener(event, func(n int) { received <- n }).
+		ScheduleEmitAt(time.Now().Add(10*time.Millisecond), event, 1)
+
+	select {
+	case <-received:
+		t.Error("Emitted the scheduled event before the scheduler was started.")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	emitter.StartScheduler()
+	defer emitter.StopScheduler()
+
+	select {
+	case n := <-received:
+		if 1 != n {
+			t.Error("Failed to emit the arguments.", n)
+		}
+	case <-time.After(time.Second):
+		t.Error("Failed to emit the event once its time had passed.")
+	}
+
+	emitter.ScheduleEmitAt(time.Now().Add(10*time.Millisecond), event, 2).Unschedule(event)
+
+	select {
+	case <-received:
+		t.Error("Emitted the event after it was unscheduled.")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestScheduleEmitInvalid(t *testing.T) {
+	var received error
+
+	NewEmitter().
+		RecoverWith(func(event, listener interface{}, err error) { received = err }).
+		ScheduleEmit("* * *", "test")
+
+	if ErrInvalidSchedule != received {
+		t.Error("Failed to reject the invalid cron expression.", received)
+	}
+}
+
+func TestStopSchedulerOnClose(t *testing.T) {
+	emitter := NewEmitter().
+		ScheduleEmit("* * * * *", "test").
+		StartScheduler()
+
+	emitter.Close()
+
+	if nil != emitter.scheduler || 0 != len(emitter.schedules) {
+		t.Error("Failed to stop the scheduler when closed.")
+	}
+}
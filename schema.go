@@ -0,0 +1,96 @@
+package emission
+
+import (
+	"errors"
+	"reflect"
+)
+
+// Error presented when a listener's signature does not match the prototype
+// registered for its event.
+var ErrSignatureMismatch = errors.New("Listener signature does not match the event's prototype.")
+
+// RegisterEvent registers the prototype, a function, as the signature of
+// the event's listeners. Adding a listener to the event whose parameters do
+// not match the prototype's then fails with ErrSignatureMismatch, and
+// emitting the event with arguments which do not align with the prototype's
+// parameters fails with ErrArgumentMismatch before any listener is called.
+// Either panics, or calls the RecoveryListener with the error if one has
+// been set, while TryAddListener and TryEmit return it. Listeners with the
+// canonical func(...interface{}) signature or accepting the Event envelope
+// match any prototype. If the prototype is not a function, RegisterEvent
+// panics with ErrNoneFunction or calls the RecoveryListener with it.
+func (emitter *Emitter) RegisterEvent(event, prototype interface{}) *Emitter {
+	if emitter.rejectEvent(event) {
+		return emitter
+	}
+
+	t := reflect.TypeOf(prototype)
+
+	if nil == t || reflect.Func != t.Kind() {
+		if recoverer := emitter.recovery(); nil == recoverer {
+			panic(ErrNoneFunction)
+		} else {
+			recoverer(event, prototype, ErrNoneFunction)
+			return emitter
+		}
+	}
+
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	if nil == emitter.schemas {
+		emitter.schemas = make(map[interface{}]reflect.Type)
+	}
+
+	emitter.schemas[event] = t
+	emitter.publish()
+	return emitter
+}
+
+// matches reports whether the listener's parameters match the prototype,
+// a listener's context.Context and event parameters being ignored.
+func matches(prototype reflect.Type, l *listenerRecord) bool {
+	if nil != l.direct || nil != l.envelope || nil != l.batch {
+		return true
+	}
+
+	t := l.fn.Type()
+	params := l.params
+
+	if l.takesContext && (0 == prototype.NumIn() || contextType != prototype.In(0)) {
+		params = params[1:]
+	}
+
+	if l.withEvent && 0 < len(params) {
+		params = params[1:]
+	}
+
+	if len(params) != prototype.NumIn() || t.IsVariadic() != prototype.IsVariadic() {
+		return false
+	}
+
+	for i, param := range params {
+		if !prototype.In(i).AssignableTo(param) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// validate returns ErrInvalidEvent if the event cannot be used as a key,
+// or ErrArgumentMismatch if a prototype has been registered for the event
+// and the arguments do not align with its parameters.
+func (emitter *Emitter) validate(event interface{}, arguments []interface{}) error {
+	if err := checkEvent(event); nil != err {
+		return err
+	}
+
+	prototype, ok := emitter.load().schemas[event]
+
+	if ok && !assignable(prototype, arguments, marshaling{strict: true}) {
+		return ErrArgumentMismatch
+	}
+
+	return nil
+}
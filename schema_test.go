@@ -0,0 +1,48 @@
+package emission
+
+import (
+	"context"
+	"testing"
+)
+
+func TestRegisterEvent(t *testing.T) {
+	event := "test"
+
+	var recovered error
+
+	emitter := NewEmitter().
+		RegisterEvent(event, func(string, int) {}).
+		RecoverWith(func(event, listener interface{}, err error) { recovered = err })
+
+	if err := emitter.TryAddListener(event, func(name string, n int) {}); nil != err {
+		t.Error("Failed to add a matching listener.", err)
+	}
+
+	if err := emitter.TryAddListener(event, func(ctx context.Context, name interface{}, n int) {}); nil != err {
+		t.Error("Failed to add a listener accepting the parameters and a context.", err)
+	}
+
+	if err := emitter.TryAddListener(event, func(...interface{}) {}); nil != err {
+		t.Error("Failed to add a canonical listener.", err)
+	}
+
+	if err := emitter.TryAddListener(event, func(n int) {}); ErrSignatureMismatch != err {
+		t.Error("Failed to refuse a mismatching listener.", err)
+	}
+
+	emitter.RemoveAllListeners(event).On(event, func(name string, n int) {})
+
+	if err := emitter.TryEmit(event, 1, "a"); ErrArgumentMismatch != err {
+		t.Error("Failed to refuse mismatching arguments.", err)
+	}
+
+	emitter.Emit(event, "a")
+
+	if ErrArgumentMismatch != recovered {
+		t.Error("Failed to recover from mismatching arguments.", recovered)
+	}
+
+	if err := emitter.TryEmit(event, "a", 1); nil != err {
+		t.Error("Failed to emit matching arguments.", err)
+	}
+}
@@ -0,0 +1,82 @@
+package emission
+
+// Default delimiter separating the prefix of a Scope from its events when
+// the Emitter has no delimiter set.
+const DefaultScopeDelimiter = "."
+
+// Scope is a view of an Emitter whose string events are prefixed with the
+// Scope's name, giving modules sharing an Emitter their own namespace.
+// Listeners added through a Scope are stored with the Emitter's, and may be
+// called by emitting the prefixed event on the Emitter directly.
+type Scope struct {
+	// The Emitter the Scope is a view of.
+	emitter *Emitter
+	// Prefix of the Scope's events, including the delimiter.
+	prefix string
+}
+
+// Scope returns a view of the Emitter prefixing string events with the name
+// and the Emitter's delimiter, or DefaultScopeDelimiter if none is set, so
+// that emitting "invoice.paid" on the Scope "billing" emits
+// "billing.invoice.paid". Events of other types are not prefixed.
+func (emitter *Emitter) Scope(name string) *Scope {
+	delimiter := emitter.load().delimiter
+
+	if "" == delimiter {
+		delimiter = DefaultScopeDelimiter
+	}
+
+	return &Scope{emitter, name + delimiter}
+}
+
+// Scope returns a view of the Scope's Emitter nested within the Scope,
+// prefixing string events with both names.
+func (s *Scope) Scope(name string) *Scope {
+	child := s.emitter.Scope(name)
+	child.prefix = s.prefix + child.prefix
+	return child
+}
+
+// Emitter returns the Emitter the Scope is a view of.
+func (s *Scope) Emitter() *Emitter {
+	return s.emitter
+}
+
+// Event returns the event as emitted on the Scope's Emitter.
+func (s *Scope) Event(event interface{}) interface{} {
+	if name, ok := event.(string); ok {
+		return s.prefix + name
+	}
+
+	return event
+}
+
+// On adds the listener for the Scope's event as the Emitter's On does.
+func (s *Scope) On(event, listener interface{}) *Scope {
+	s.emitter.On(s.Event(event), listener)
+	return s
+}
+
+// Once adds the listener for the Scope's event as the Emitter's Once does.
+func (s *Scope) Once(event, listener interface{}) *Scope {
+	s.emitter.Once(s.Event(event), listener)
+	return s
+}
+
+// Off removes the listener of the Scope's event as the Emitter's Off does.
+func (s *Scope) Off(event, listener interface{}) *Scope {
+	s.emitter.Off(s.Event(event), listener)
+	return s
+}
+
+// Emit emits the Scope's event as the Emitter's Emit does.
+func (s *Scope) Emit(event interface{}, arguments ...interface{}) *Scope {
+	s.emitter.Emit(s.Event(event), arguments...)
+	return s
+}
+
+// EmitSync emits the Scope's event as the Emitter's EmitSync does.
+func (s *Scope) EmitSync(event interface{}, arguments ...interface{}) *Scope {
+	s.emitter.EmitSync(s.Event(event), arguments...)
+	return s
+}
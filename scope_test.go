@@ -0,0 +1,35 @@
+package emission
+
+import (
+	"testing"
+)
+
+func TestScope(t *testing.T) {
+	emitter := NewEmitter()
+	billing := emitter.Scope("billing")
+	received := []string{}
+
+	billing.On("invoice.paid", func(id string) { received = append(received, "scoped "+id) })
+	emitter.On("billing.invoice.paid", func(id string) { received = append(received, id) })
+	emitter.On("invoice.paid", func(id string) { received = append(received, "unscoped "+id) })
+
+	billing.EmitSync("invoice.paid", "1")
+
+	if 2 != len(received) || "scoped 1" != received[0] || "1" != received[1] {
+		t.Error("Failed to prefix the Scope's events.", received)
+	}
+
+	if "billing.eu.invoice.paid" != billing.Scope("eu").Event("invoice.paid") {
+		t.Error("Failed to prefix the nested Scope's events.")
+	}
+
+	if 1 != billing.Event(1) {
+		t.Error("Prefixed an event which is not a string.")
+	}
+}
+
+func TestScopeDelimiter(t *testing.T) {
+	if "billing:paid" != NewEmitter().SetDelimiter(":").Scope("billing").Event("paid") {
+		t.Error("Failed to prefix the Scope's events with the Emitter's delimiter.")
+	}
+}
@@ -0,0 +1,22 @@
+package emission
+
+import (
+	"time"
+)
+
+// SlowListener is called with the event, the handle of the listener and the
+// duration of a listener call exceeding the slow listener threshold.
+type SlowListener func(event interface{}, handle Handle, duration time.Duration)
+
+// SetSlowListenerThreshold sets the callback called once a listener call
+// returns if it lasted longer than the threshold. Passing a nil callback
+// disables the detection of slow listeners, the default.
+func (emitter *Emitter) SetSlowListenerThreshold(threshold time.Duration, callback SlowListener) *Emitter {
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	emitter.slowThreshold = threshold
+	emitter.slow = callback
+	emitter.publish()
+	return emitter
+}
@@ -0,0 +1,23 @@
+package emission
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSetSlowListenerThreshold(t *testing.T) {
+	event := "test"
+	slow := []Handle{}
+
+	emitter := NewEmitter().
+		SetSlowListenerThreshold(5*time.Millisecond, func(event interface{}, handle Handle, duration time.Duration) {
+			slow = append(slow, handle)
+		}).
+		On(event, func() {}).
+		On(event, func() { time.Sleep(10 * time.Millisecond) }).
+		EmitSync(event)
+
+	if 1 != len(slow) || emitter.Listeners(event)[1].Handle != slow[0] {
+		t.Error("Failed to report the slow listener.", slow)
+	}
+}
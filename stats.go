@@ -0,0 +1,65 @@
+package emission
+
+import (
+	"time"
+)
+
+// ListenerStats describes the calls of a listener added to an Emitter.
+type ListenerStats struct {
+	// Handle identifying the listener.
+	Handle Handle
+	// Name of the listener, defaulting to the name of its function.
+	Name string
+	// Number of times the listener has been called.
+	Calls int
+	// Cumulative duration of the listener's calls.
+	Duration time.Duration
+	// Time the listener was last called, or the zero Time if it has not
+	// been called.
+	LastCalled time.Time
+	// Number of calls of the listener which panicked.
+	Panics int
+}
+
+// Stats returns statistics about the calls of the listeners added for the
+// event, in the order they are called.
+func (emitter *Emitter) Stats(event interface{}) []ListenerStats {
+	if emitter.rejectEvent(event) {
+		return []ListenerStats{}
+	}
+
+	emitter.RLock()
+	defer emitter.RUnlock()
+
+	stats := []ListenerStats{}
+
+	for _, l := range emitter.load().events[event] {
+		var last time.Time
+
+		if nanos := l.lastCalled.Load(); 0 != nanos {
+			last = time.Unix(0, nanos)
+		}
+
+		stats = append(stats, ListenerStats{
+			Handle:     l.handle,
+			Name:       l.displayName(),
+			Calls:      int(l.calls.Load()),
+			Duration:   time.Duration(l.duration.Load()),
+			LastCalled: last,
+			Panics:     int(l.panics.Load()),
+		})
+	}
+
+	return stats
+}
+
+// track records a call of the listener started at the time and lasting
+// the duration.
+func (l *listenerRecord) track(start time.Time, duration time.Duration, panicked bool) {
+	l.duration.Add(int64(duration))
+	l.lastCalled.Store(start.UnixNano())
+
+	if panicked {
+		l.panics.Add(1)
+	}
+}
@@ -0,0 +1,35 @@
+package emission
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStats(t *testing.T) {
+	event := "test"
+
+	emitter := NewEmitter().
+		RecoverWith(func(event, listener interface{}, err error) {}).
+		On(event, func() { time.Sleep(time.Millisecond) }).
+		On(event, func() { panic("test") })
+
+	if stats := emitter.Stats(event); 0 != stats[0].Calls || !stats[0].LastCalled.IsZero() {
+		t.Error("Failed to report listeners which have not been called.", stats)
+	}
+
+	emitter.EmitSync(event).EmitSync(event)
+
+	stats := emitter.Stats(event)
+
+	if 2 != len(stats) {
+		t.Fatal("Failed to report every listener.", stats)
+	}
+
+	if 2 != stats[0].Calls || 2*time.Millisecond > stats[0].Duration || stats[0].LastCalled.IsZero() || 0 != stats[0].Panics {
+		t.Error("Failed to track the listener's calls.", stats[0])
+	}
+
+	if 2 != stats[1].Calls || 2 != stats[1].Panics {
+		t.Error("Failed to track the listener's panics.", stats[1])
+	}
+}
@@ -0,0 +1,47 @@
+package emission
+
+// EmitSticky emits the event as Emit does, and caches its arguments so
+// that listeners added for the event afterwards are immediately called
+// with them. Each sticky emission replaces the event's cached arguments.
+func (emitter *Emitter) EmitSticky(event interface{}, arguments ...interface{}) *Emitter {
+	if emitter.rejectEvent(event) {
+		return emitter
+	}
+
+	emitter.lazyInit()
+	emitter.setSticky(event, arguments)
+	return emitter.Emit(event, arguments...)
+}
+
+// setSticky caches the arguments of the event's sticky emission.
+func (emitter *Emitter) setSticky(event interface{}, arguments []interface{}) {
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	emitter.sticky[event] = arguments
+}
+
+// RemoveSticky removes the arguments cached for the event by EmitSticky.
+func (emitter *Emitter) RemoveSticky(event interface{}) *Emitter {
+	if emitter.rejectEvent(event) {
+		return emitter
+	}
+
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	delete(emitter.sticky, event)
+	return emitter
+}
+
+// replaySticky calls the newly added listener with the arguments cached
+// for its event by EmitSticky, if any.
+func (emitter *Emitter) replaySticky(record *listenerRecord) {
+	emitter.RLock()
+	arguments, ok := emitter.sticky[record.event]
+	emitter.RUnlock()
+
+	if ok {
+		emitter.call(nil, record.event, record, arguments)
+	}
+}
@@ -0,0 +1,25 @@
+package emission
+
+import (
+	"testing"
+)
+
+func TestEmitSticky(t *testing.T) {
+	event := "test"
+	received := []int{}
+
+	emitter := NewEmitter().
+		EmitSticky(event, 1).
+		EmitSticky(event, 2).
+		AddListener(event, func(value int) { received = append(received, value) })
+
+	if 1 != len(received) || 2 != received[0] {
+		t.Error("Failed to call the late listener with the sticky arguments.", received)
+	}
+
+	emitter.RemoveSticky(event).AddListener(event, func(value int) { received = append(received, value) })
+
+	if 1 != len(received) {
+		t.Error("Called the late listener after the sticky arguments were removed.", received)
+	}
+}
@@ -0,0 +1,59 @@
+package emission
+
+import (
+	"sync"
+)
+
+// Subscribe returns a channel receiving the arguments of each emission of
+// the event, buffering up to buffer emissions, along with a function which
+// unsubscribes from the event and closes the channel. While the channel's
+// buffer is full, emitting the event blocks until the channel is read from
+// or the subscription is canceled. If the listener cannot be added and a
+// RecoveryListener has been set, the channel is returned closed.
+func (emitter *Emitter) Subscribe(event interface{}, buffer int) (<-chan []interface{}, func()) {
+	var (
+		mutex  sync.Mutex
+		once   sync.Once
+		closed bool
+	)
+
+	channel := make(chan []interface{}, buffer)
+	done := make(chan struct{})
+
+	record := emitter.addListener(event, func(arguments ...interface{}) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		if closed {
+			return
+		}
+
+		select {
+		case channel <- arguments:
+		case <-done:
+		}
+	}, &listenerRecord{}, false)
+
+	if nil == record {
+		// The listener could not be added and the RecoveryListener was
+		// called instead, so the subscription is canceled from the start.
+		close(channel)
+		return channel, func() {}
+	}
+
+	unsubscribe := func() {
+		once.Do(func() {
+			// Close done first so that a listener blocked sending
+			// releases the mutex before the channel is closed.
+			close(done)
+			emitter.removeRecord(record)
+
+			mutex.Lock()
+			closed = true
+			close(channel)
+			mutex.Unlock()
+		})
+	}
+
+	return channel, unsubscribe
+}
@@ -0,0 +1,42 @@
+package emission
+
+import (
+	"testing"
+)
+
+func TestSubscribe(t *testing.T) {
+	event := "test"
+	emitter := NewEmitter()
+
+	channel, unsubscribe := emitter.Subscribe(event, 1)
+	emitter.Emit(event, 1, "a")
+
+	if arguments := <-channel; 2 != len(arguments) || 1 != arguments[0] || "a" != arguments[1] {
+		t.Error("Failed to receive the emitted arguments from the channel.")
+	}
+
+	unsubscribe()
+
+	if _, ok := <-channel; ok {
+		t.Error("Failed to close the channel when unsubscribing.")
+	}
+
+	if 0 != emitter.GetListenerCount(event) {
+		t.Error("Failed to remove the listener when unsubscribing.")
+	}
+}
+
+func TestSubscribeClosed(t *testing.T) {
+	var recovered error
+
+	emitter := NewEmitter().
+		RecoverWith(func(event, listener interface{}, err error) { recovered = err })
+	emitter.Close()
+
+	channel, unsubscribe := emitter.Subscribe("test", 1)
+	unsubscribe()
+
+	if _, ok := <-channel; ok || ErrClosed != recovered {
+		t.Error("Failed to return a closed channel when subscribing to a closed emitter.", recovered)
+	}
+}
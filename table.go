@@ -0,0 +1,222 @@
+package emission
+
+import (
+	"log/slog"
+	"reflect"
+	"regexp"
+	"sort"
+	"time"
+)
+
+// table holds the Emitter's listeners and a snapshot of its settings. A
+// table is never modified once it has been stored by the Emitter; changes
+// are made to a copy which then replaces it, so that emitting events reads
+// the listeners and settings without taking the Emitter's mutex.
+type table struct {
+	// Map of event to a slice of listeners.
+	events map[interface{}][]*listenerRecord
+	// Patterns added with OnMatch, in the order they were added.
+	patterns []*regexp.Regexp
+	// Delimiter separating the segments of hierarchical events.
+	delimiter string
+	// Whether any listener observes the Event of an emission, accepting it
+	// or a context.Context holding it.
+	observed bool
+	// Snapshot of the Emitter's settings read when emitting.
+	settings
+}
+
+// settings are the Emitter's settings read when emitting, copied from the
+// Emitter's fields by publish whenever one of them is changed.
+type settings struct {
+	// Whether the Emitter has been closed.
+	closed bool
+	// Whether the Emitter is paused, buffering emissions.
+	paused bool
+	// Whether any event is debounced, throttled or coalesced.
+	debouncing, throttling, coalescing bool
+	// Map of event to the prototype of its listeners, if registered.
+	schemas map[interface{}]reflect.Type
+	// Map of event to its sampler, if sampled.
+	samplers map[interface{}]*sampler
+	// Maximum number of emissions an emission may be nested within, if
+	// non-zero.
+	maxDepth int
+	// Optional event loop processing every emission.
+	loop *loop
+	// Number of latest emissions recorded per event.
+	historySize int
+	// Optional logger the Emitter logs to.
+	logger *slog.Logger
+	// Observers notified of the Emitter's activity.
+	observers []Observer
+	// Hooks called before and after every emission.
+	beforeHooks, afterHooks []EmitHook
+	// Middleware wrapping every emission.
+	middleware []ContextMiddleware
+	// Optional UnhandledListener to call when an event has no listeners.
+	unhandled UnhandledListener
+	// Number of calls each listener's mailbox holds, if enabled.
+	mailboxSize int
+	// Order in which Emit starts listeners.
+	ordering Ordering
+	// Optional Dispatcher scheduling calls of listeners.
+	dispatcher Dispatcher
+	// Whether listeners called within their own go routine are labeled.
+	labeled bool
+	// Number of panics tripping a listener's circuit breaker, if enabled.
+	breakerThreshold int
+	// Optional RetryPolicy redelivering failed listener calls.
+	retry *RetryPolicy
+	// Duration beyond which a listener call is slow.
+	slowThreshold time.Duration
+	// Optional SlowListener called when a listener call is slow.
+	slow SlowListener
+	// How arguments are marshaled into the parameters of listeners.
+	marshaling marshaling
+}
+
+// newTable returns a new, empty table.
+func newTable() *table {
+	return &table{events: make(map[interface{}][]*listenerRecord)}
+}
+
+// clone returns a copy of the table which may be modified. Slices of the
+// copy must be replaced rather than modified in place.
+func (t *table) clone() *table {
+	c := &table{
+		events:    make(map[interface{}][]*listenerRecord, len(t.events)),
+		patterns:  t.patterns[:len(t.patterns):len(t.patterns)],
+		delimiter: t.delimiter,
+		settings:  t.settings,
+	}
+
+	for event, listeners := range t.events {
+		c.events[event] = listeners
+	}
+
+	return c
+}
+
+// listenersFor returns the listeners to call when the event is emitted,
+// followed by the listeners of its ancestors, of the patterns it matches
+// and any listeners added for the Any event. The slice returned must not be
+// modified, as it may be shared with the table.
+func (t *table) listenersFor(event interface{}) []*listenerRecord {
+	listeners := t.events[event]
+	gathered := false
+
+	gather := func(more []*listenerRecord) {
+		if 0 != len(more) {
+			listeners = append(listeners[:len(listeners):len(listeners)], more...)
+			gathered = true
+		}
+	}
+
+	for _, ancestor := range t.ancestors(event) {
+		gather(t.events[ancestor])
+	}
+
+	for _, pattern := range t.matches(event) {
+		gather(t.events[pattern])
+	}
+
+	if _, ok := event.(metaEvent); !ok && Any != event {
+		gather(t.events[Any])
+	}
+
+	// Order listeners gathered from several events by priority.
+	if gathered {
+		sort.SliceStable(listeners, func(i, j int) bool {
+			return listeners[i].priority > listeners[j].priority
+		})
+	}
+
+	return listeners
+}
+
+// observes reports whether any of the table's listeners observes the Event
+// of an emission.
+func (t *table) observes() bool {
+	for _, listeners := range t.events {
+		for _, l := range listeners {
+			if l.takesContext || nil != l.envelope || nil != l.batch {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Table of a zero value Emitter, which has no listeners.
+var emptyTable = newTable()
+
+// load returns the Emitter's current table.
+func (emitter *Emitter) load() *table {
+	if t := emitter.table.Load(); nil != t {
+		return t
+	}
+
+	return emptyTable
+}
+
+// modify replaces the Emitter's table with a copy changed by fn. The
+// Emitter's mutex must be held.
+func (emitter *Emitter) modify(fn func(*table)) {
+	t := emitter.load().clone()
+	fn(t)
+	t.observed = t.observes()
+	emitter.table.Store(t)
+}
+
+// publish snapshots the Emitter's settings into its table. It must be
+// called with the Emitter's mutex held whenever one of them is changed.
+func (emitter *Emitter) publish() {
+	s := settings{
+		closed:           emitter.closed,
+		paused:           emitter.paused,
+		debouncing:       0 != len(emitter.debouncers),
+		throttling:       0 != len(emitter.throttlers),
+		coalescing:       0 != len(emitter.coalesced),
+		maxDepth:         emitter.maxDepth,
+		loop:             emitter.loop,
+		historySize:      emitter.historySize,
+		logger:           emitter.logger,
+		observers:        emitter.observers,
+		beforeHooks:      emitter.beforeHooks,
+		afterHooks:       emitter.afterHooks,
+		middleware:       emitter.middleware,
+		unhandled:        emitter.unhandled,
+		mailboxSize:      emitter.mailboxSize,
+		ordering:         emitter.ordering,
+		dispatcher:       emitter.dispatcher,
+		labeled:          emitter.labeled,
+		breakerThreshold: emitter.breakerThreshold,
+		retry:            emitter.retry,
+		slowThreshold:    emitter.slowThreshold,
+		slow:             emitter.slow,
+		marshaling:       emitter.marshaling,
+	}
+
+	// The Emitter's maps are modified in place, so the snapshot copies them.
+	if 0 != len(emitter.schemas) {
+		s.schemas = make(map[interface{}]reflect.Type, len(emitter.schemas))
+
+		for event, prototype := range emitter.schemas {
+			s.schemas[event] = prototype
+		}
+	}
+
+	if 0 != len(emitter.samplers) {
+		s.samplers = make(map[interface{}]*sampler, len(emitter.samplers))
+
+		for event, sampler := range emitter.samplers {
+			s.samplers[event] = sampler
+		}
+	}
+
+	emitter.modify(func(t *table) {
+		t.settings = s
+	})
+}
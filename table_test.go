@@ -0,0 +1,37 @@
+package emission
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestConcurrentEmitAndAddListener(t *testing.T) {
+	event := "test"
+	emitter := NewEmitter().SetMaxListeners(-1)
+
+	var wg sync.WaitGroup
+
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+
+		for i := 0; i < 100; i++ {
+			emitter.AddListener(event, func() {})
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		for i := 0; i < 100; i++ {
+			emitter.EmitSync(event)
+		}
+	}()
+
+	wg.Wait()
+
+	if 100 != emitter.GetListenerCount(event) {
+		t.Error("Failed to add every listener while emitting.")
+	}
+}
@@ -0,0 +1,43 @@
+package emission
+
+// WithTag tags the listener with the tag, so that it may be removed along
+// with every other listener tagged with it by RemoveByTag. A listener may
+// be tagged with several tags.
+func WithTag(tag string) ListenerOption {
+	return func(l *listenerRecord) {
+		l.tags = append(l.tags, tag)
+	}
+}
+
+// RemoveByTag removes every listener tagged with the tag, of every event,
+// emitting the RemoveListenerEvent for each.
+func (emitter *Emitter) RemoveByTag(tag string) *Emitter {
+	var tagged []*listenerRecord
+
+	emitter.RLock()
+
+	for _, record := range emitter.handles {
+		if record.tagged(tag) {
+			tagged = append(tagged, record)
+		}
+	}
+
+	emitter.RUnlock()
+
+	for _, record := range tagged {
+		emitter.removeRecord(record)
+	}
+
+	return emitter
+}
+
+// tagged reports whether the listener is tagged with the tag.
+func (l *listenerRecord) tagged(tag string) bool {
+	for _, t := range l.tags {
+		if tag == t {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,24 @@
+package emission
+
+import (
+	"testing"
+)
+
+func TestRemoveByTag(t *testing.T) {
+	received := []string{}
+
+	emitter := NewEmitter().
+		OnWith("a", func() { received = append(received, "billing a") }, WithTag("billing")).
+		OnWith("b", func() { received = append(received, "billing b") }, WithTag("plugin"), WithTag("billing")).
+		OnWith("a", func() { received = append(received, "shipping a") }, WithTag("shipping"))
+
+	if infos := emitter.Listeners("b"); 1 != len(infos) || 2 != len(infos[0].Tags) {
+		t.Error("Failed to describe the listener's tags.", infos)
+	}
+
+	emitter.RemoveByTag("billing").EmitSync("a").EmitSync("b")
+
+	if 1 != len(received) || "shipping a" != received[0] {
+		t.Error("Failed to remove every listener tagged with the tag.", received)
+	}
+}
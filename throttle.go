@@ -0,0 +1,127 @@
+package emission
+
+import "time"
+
+// throttler holds back the emissions of a throttled event.
+type throttler struct {
+	// Minimum interval between the emissions released.
+	interval time.Duration
+	// Time the last emission was released.
+	last time.Time
+	// Latest emission held back, if any.
+	latest *emission
+	// Timer releasing the latest emission.
+	timer *time.Timer
+}
+
+// SetThrottle throttles the event, releasing at most one of its emissions
+// each interval. An emission occurring within the interval of the last
+// released is held back, replacing any held back before it, and released
+// within a go routine of its own once the interval has elapsed. An interval
+// of 0 or less stops throttling the event, releasing any emission held back.
+func (emitter *Emitter) SetThrottle(event interface{}, interval time.Duration) *Emitter {
+	if emitter.rejectEvent(event) {
+		return emitter
+	}
+
+	emitter.Lock()
+
+	t := emitter.throttlers[event]
+
+	if 0 < interval {
+		if nil == emitter.throttlers {
+			emitter.throttlers = make(map[interface{}]*throttler)
+		}
+
+		if nil == t {
+			emitter.throttlers[event] = &throttler{interval: interval}
+		} else {
+			t.interval = interval
+		}
+
+		emitter.publish()
+		emitter.Unlock()
+		return emitter
+	}
+
+	delete(emitter.throttlers, event)
+
+	var latest *emission
+
+	if nil != t && nil != t.latest && t.timer.Stop() {
+		latest = t.latest
+		t.latest = nil
+	}
+
+	emitter.publish()
+	emitter.Unlock()
+
+	if nil != latest {
+		emitter.release(latest.ctx, latest.event, latest.arguments, latest.dispatch)
+	}
+
+	return emitter
+}
+
+// throttle holds back the emission if its event is throttled and an
+// emission of it was released within the interval, reporting whether it
+// was held back.
+func (emitter *Emitter) throttle(e emission) bool {
+	if !emitter.load().throttling {
+		return false
+	}
+
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	t := emitter.throttlers[e.event]
+
+	if nil == t {
+		return false
+	}
+
+	now := time.Now()
+	elapsed := now.Sub(t.last)
+
+	if nil == t.latest && elapsed >= t.interval {
+		t.last = now
+		return false
+	}
+
+	if nil == t.latest {
+		t.timer = time.AfterFunc(t.interval-elapsed, func() {
+			emitter.Lock()
+			latest := t.latest
+			t.latest = nil
+			t.last = time.Now()
+			emitter.Unlock()
+
+			if nil != latest {
+				emitter.release(latest.ctx, latest.event, latest.arguments, latest.dispatch)
+			}
+		})
+	}
+
+	if nil != t.latest {
+		discard(t.latest.ctx)
+	}
+
+	t.latest = &e
+	return true
+}
+
+// stopThrottlers stops throttling every event, discarding the emissions
+// held back. It must be called with the Emitter's mutex held.
+func (emitter *Emitter) stopThrottlers() {
+	for _, t := range emitter.throttlers {
+		if nil != t.timer {
+			t.timer.Stop()
+		}
+
+		if nil != t.latest {
+			discard(t.latest.ctx)
+		}
+	}
+
+	emitter.throttlers = nil
+}
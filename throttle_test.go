@@ -0,0 +1,51 @@
+package emission
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSetThrottle(t *testing.T) {
+	event := "test"
+	received := make(chan int, 4)
+
+	emitter := NewEmitter().
+		SetThrottle(event, 20*time.Millisecond).
+		AddListener(event, func(n int) { received <- n })
+
+	emitter.EmitSync(event, 1).EmitSync(event, 2).EmitSync(event, 3)
+
+	for _, expected := range []int{1, 3} {
+		select {
+		case n := <-received:
+			if expected != n {
+				t.Error("Failed to release the first and latest emissions.", n)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("Failed to release the throttled emission.")
+		}
+	}
+
+	select {
+	case n := <-received:
+		t.Error("Failed to drop the intermediate emission.", n)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestSetThrottleDisabled(t *testing.T) {
+	event := "test"
+	received := []int{}
+
+	NewEmitter().
+		SetThrottle(event, time.Hour).
+		AddListener(event, func(n int) { received = append(received, n) }).
+		EmitSync(event, 1).
+		EmitSync(event, 2).
+		SetThrottle(event, 0).
+		EmitSync(event, 3)
+
+	if 3 != len(received) || 1 != received[0] || 2 != received[1] || 3 != received[2] {
+		t.Error("Failed to release the emission held back once no longer throttled.", received)
+	}
+}
@@ -0,0 +1,80 @@
+package emission
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Error supplied to the RecoveryListener when a listener exceeds its timeout.
+var ErrListenerTimeout = errors.New("Listener timed out.")
+
+// OnWithTimeout adds the listener as AddListener does, limiting each of its
+// calls by Emit and EmitSync to the timeout. Once a call exceeds it, the
+// RecoveryListener is called with ErrListenerTimeout if one has been set and
+// the emission stops waiting for the listener, which is left to return
+// within its own go routine.
+func (emitter *Emitter) OnWithTimeout(event, listener interface{}, timeout time.Duration) *Emitter {
+	emitter.addListener(event, listener, &listenerRecord{timeout: timeout}, false)
+	return emitter
+}
+
+// timed calls the listener as call does, waiting no longer than the
+// listener's timeout, if any, for it to return. A listener left running
+// once its timeout is exceeded is still counted as in flight, so that Wait
+// and Close wait for it to return.
+func (emitter *Emitter) timed(ctx context.Context, event interface{}, l *listenerRecord, arguments []interface{}) {
+	if 0 >= l.timeout {
+		emitter.call(ctx, event, l, arguments)
+		return
+	}
+
+	done := make(chan struct{})
+
+	emitter.begin()
+
+	go func() {
+		defer emitter.end()
+		defer close(done)
+
+		emitter.call(ctx, event, l, arguments)
+	}()
+
+	timer := time.NewTimer(l.timeout)
+	defer timer.Stop()
+
+	select {
+	case <-done:
+	case <-timer.C:
+		if recoverer := emitter.recovery(); nil != recoverer {
+			recoverer(event, l.fn.Interface(), ErrListenerTimeout)
+		}
+	}
+}
+
+// EmitSyncTimeout calls each listener synchronously as EmitSync does, but
+// stops calling the remaining listeners once the timeout has elapsed. The
+// listeners skipped are returned, described as Listeners would describe them.
+// As with EmitCollect, the emission is never deferred.
+func (emitter *Emitter) EmitSyncTimeout(timeout time.Duration, event interface{}, arguments ...interface{}) []ListenerInfo {
+	deadline := time.Now().Add(timeout)
+	skipped := []ListenerInfo{}
+
+	emitter.emitNow(nil, Event{Name: event, Args: arguments}, func(ctx context.Context, event interface{}, listeners []*listenerRecord, arguments []interface{}) {
+		for i, l := range listeners {
+			if time.Now().After(deadline) {
+				emitter.RLock()
+				for j, remaining := range listeners[i:] {
+					skipped = append(skipped, remaining.info(i+j))
+				}
+				emitter.RUnlock()
+
+				return
+			}
+
+			emitter.timed(ctx, event, l, arguments)
+		}
+	})
+
+	return skipped
+}
@@ -0,0 +1,89 @@
+package emission
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestOnWithTimeout(t *testing.T) {
+	event := "test"
+	release := make(chan struct{})
+	defer close(release)
+
+	var recovered error
+
+	emitter := NewEmitter().
+		RecoverWith(func(event, listener interface{}, err error) { recovered = err }).
+		OnWithTimeout(event, func() { <-release }, 10*time.Millisecond)
+
+	start := time.Now()
+	emitter.EmitSync(event)
+
+	if time.Since(start) > time.Second {
+		t.Error("Failed to stop waiting for the listener once it timed out.")
+	}
+
+	if ErrListenerTimeout != recovered {
+		t.Error("Failed to report the timeout to the RecoveryListener.", recovered)
+	}
+
+	recovered = nil
+	emitter.Emit(event)
+
+	if ErrListenerTimeout != recovered {
+		t.Error("Failed to report the timeout from Emit.", recovered)
+	}
+}
+
+func TestOnWithTimeoutWait(t *testing.T) {
+	release := make(chan struct{})
+	var returned atomic.Bool
+
+	emitter := NewEmitter().
+		RecoverWith(func(event, listener interface{}, err error) {}).
+		OnWithTimeout("test", func() {
+			<-release
+			returned.Store(true)
+		}, 10*time.Millisecond)
+
+	emitter.EmitSync("test")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if nil == emitter.WaitContext(ctx) {
+		t.Error("Failed to wait for the listener left running after its timeout.")
+	}
+
+	close(release)
+	emitter.Wait()
+
+	if !returned.Load() {
+		t.Error("Failed to wait for the listener to return.")
+	}
+}
+
+func TestEmitSyncTimeout(t *testing.T) {
+	event := "test"
+	calls := 0
+
+	emitter := NewEmitter().
+		On(event, func() {
+			calls++
+			time.Sleep(20 * time.Millisecond)
+		}).
+		On(event, func() { calls++ })
+
+	if skipped := emitter.EmitSyncTimeout(time.Second, event); 0 != len(skipped) || 2 != calls {
+		t.Error("Failed to call every listener before the timeout.", skipped, calls)
+	}
+
+	calls = 0
+	skipped := emitter.EmitSyncTimeout(10*time.Millisecond, event)
+
+	if 1 != calls || 1 != len(skipped) || 1 != skipped[0].Order {
+		t.Error("Failed to skip the listeners remaining after the timeout.", skipped, calls)
+	}
+}
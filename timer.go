@@ -0,0 +1,83 @@
+package emission
+
+import (
+	"sync"
+	"time"
+)
+
+// Timer is an emission scheduled by EmitAfter or EmitEvery.
+type Timer struct {
+	// Function preventing further emissions, reporting whether it did.
+	stop func() bool
+}
+
+// Stop prevents the scheduled emissions, reporting whether it prevented
+// any, false if the Timer was already stopped or its event already emitted
+// by EmitAfter.
+func (t *Timer) Stop() bool {
+	return t.stop()
+}
+
+// EmitAfter emits the event with the arguments as Emit does once the delay
+// has elapsed, within its own go routine, returning a Timer which may be
+// stopped to cancel the emission.
+func (emitter *Emitter) EmitAfter(delay time.Duration, event interface{}, arguments ...interface{}) *Timer {
+	if emitter.rejectEvent(event) {
+		return &Timer{func() bool { return false }}
+	}
+
+	timer := time.AfterFunc(delay, func() {
+		emitter.Emit(event, arguments...)
+	})
+
+	return &Timer{timer.Stop}
+}
+
+// EmitEvery emits the event as Emit does each time the interval elapses,
+// within a go routine of its own, until the returned Timer is stopped or
+// the Emitter is closed. The arguments of each emission are returned by
+// calling arguments, if non-nil, when it is due. Emissions are skipped
+// while the listeners of the previous one have yet to return.
+func (emitter *Emitter) EmitEvery(interval time.Duration, event interface{}, arguments func() []interface{}) *Timer {
+	if emitter.rejectEvent(event) {
+		return &Timer{func() bool { return false }}
+	}
+
+	var once sync.Once
+
+	ticker := time.NewTicker(interval)
+	quit := make(chan struct{})
+
+	go func() {
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+			case <-quit:
+				return
+			}
+
+			if emitter.isClosed() {
+				return
+			}
+
+			var values []interface{}
+
+			if nil != arguments {
+				values = arguments()
+			}
+
+			emitter.Emit(event, values...)
+		}
+	}()
+
+	return &Timer{func() (stopped bool) {
+		once.Do(func() {
+			close(quit)
+			stopped = true
+		})
+
+		return
+	}}
+}
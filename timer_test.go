@@ -0,0 +1,79 @@
+package emission
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEmitAfter(t *testing.T) {
+	event := "test"
+	received := make(chan int, 1)
+
+	NewEmitter().
+		AddListener(event, func(n int) { received <- n }).
+		EmitAfter(time.Millisecond, event, 1)
+
+	select {
+	case n := <-received:
+		if 1 != n {
+			t.Error("Failed to emit the arguments.", n)
+		}
+	case <-time.After(time.Second):
+		t.Error("Failed to emit the event after the delay.")
+	}
+}
+
+func TestEmitAfterStop(t *testing.T) {
+	event := "test"
+	received := make(chan struct{}, 1)
+
+	timer := NewEmitter().
+		AddListener(event, func() { received <- struct{}{} }).
+		EmitAfter(10*time.Millisecond, event)
+
+	if !timer.Stop() {
+		t.Error("Failed to report stopping the emission.")
+	}
+
+	select {
+	case <-received:
+		t.Error("Emitted the event after the Timer was stopped.")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	if timer.Stop() {
+		t.Error("Reported stopping an emission already stopped.")
+	}
+}
+
+func TestEmitEvery(t *testing.T) {
+	event := "test"
+	received := make(chan int, 10)
+	ticks := 0
+
+	timer := NewEmitter().
+		AddListener(event, func(n int) { received <- n }).
+		EmitEvery(time.Millisecond, event, func() []interface{} {
+			ticks = ticks + 1
+			return []interface{}{ticks}
+		})
+
+	for expected := 1; expected <= 3; expected++ {
+		select {
+		case n := <-received:
+			if expected != n {
+				t.Error("Failed to emit the arguments returned for the tick.", n)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("Failed to emit the event each interval.")
+		}
+	}
+
+	if !timer.Stop() {
+		t.Error("Failed to report stopping the emissions.")
+	}
+
+	if timer.Stop() {
+		t.Error("Reported stopping emissions already stopped.")
+	}
+}
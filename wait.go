@@ -0,0 +1,30 @@
+package emission
+
+import (
+	"context"
+)
+
+// WaitFor blocks until the event is emitted, returning the arguments it was
+// emitted with, or until ctx is done, returning its error. A listener is
+// added to the event for the duration of the call, as Once would add it,
+// the error being returned if it cannot be added, such as ErrClosed once
+// the Emitter is closed or ErrInvalidEvent.
+func (emitter *Emitter) WaitFor(ctx context.Context, event interface{}) ([]interface{}, error) {
+	received := make(chan []interface{}, 1)
+
+	record, err := emitter.add(event, func(arguments ...interface{}) {
+		received <- arguments
+	}, &listenerRecord{times: 1}, false, false)
+
+	if nil != err {
+		return nil, err
+	}
+
+	select {
+	case arguments := <-received:
+		return arguments, nil
+	case <-ctx.Done():
+		emitter.removeRecord(record)
+		return nil, ctx.Err()
+	}
+}
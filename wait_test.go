@@ -0,0 +1,63 @@
+package emission
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestWaitFor(t *testing.T) {
+	event := "test"
+	emitter := NewEmitter()
+
+	go func() {
+		for 0 == emitter.GetListenerCount(event) {
+			time.Sleep(time.Millisecond)
+		}
+
+		emitter.Emit(event, 1, "a")
+	}()
+
+	arguments, err := emitter.WaitFor(context.Background(), event)
+
+	if nil != err || 2 != len(arguments) || 1 != arguments[0] || "a" != arguments[1] {
+		t.Error("Failed to return the arguments the event was emitted with.", arguments, err)
+	}
+
+	if 0 != emitter.GetListenerCount(event) {
+		t.Error("Failed to remove the listener once the event was emitted.")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := emitter.WaitFor(ctx, event); context.DeadlineExceeded != err {
+		t.Error("Failed to return the context's error once it was done.", err)
+	}
+
+	if 0 != emitter.GetListenerCount(event) {
+		t.Error("Failed to remove the listener once the context was done.")
+	}
+}
+
+func TestWaitForInvalid(t *testing.T) {
+	emitter := NewEmitter().
+		RecoverWith(func(event, listener interface{}, err error) {})
+
+	if _, err := emitter.WaitFor(context.Background(), []int{1}); ErrInvalidEvent != err {
+		t.Error("Failed to return ErrInvalidEvent.", err)
+	}
+
+	emitter.Close()
+
+	if _, err := emitter.WaitFor(context.Background(), "test"); ErrClosed != err {
+		t.Error("Failed to return ErrClosed once the Emitter was closed.", err)
+	}
+
+	closed := NewEmitter()
+	closed.Close()
+
+	if _, err := closed.WaitFor(context.Background(), "test"); ErrClosed != err {
+		t.Error("Failed to return ErrClosed without a RecoveryListener.", err)
+	}
+}
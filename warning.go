@@ -0,0 +1,59 @@
+package emission
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+// WarningHandler is called with the event and its number of listeners when
+// the event exceeds the maximum number of listeners.
+type WarningHandler func(event interface{}, count int)
+
+// SetWarningHandler sets the handler called when an event exceeds the
+// maximum number of listeners, instead of the warning being logged or
+// written. Passing nil restores the default.
+func (emitter *Emitter) SetWarningHandler(handler WarningHandler) *Emitter {
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	emitter.warningHandler = handler
+	return emitter
+}
+
+// SetWarningWriter sets the writer the warning is written to when an event
+// exceeds the maximum number of listeners, unless a WarningHandler or a
+// logger has been set. Passing nil restores the default, os.Stdout.
+func (emitter *Emitter) SetWarningWriter(writer io.Writer) *Emitter {
+	emitter.Lock()
+	defer emitter.Unlock()
+
+	emitter.warningWriter = writer
+	return emitter
+}
+
+// warn warns that the event has exceeded the maximum number of listeners
+// with count listeners by adding the named listener, through the Emitter's
+// WarningHandler if one has been set, else its logger if one has been set,
+// else its warning writer.
+func (emitter *Emitter) warn(event interface{}, count int, name string) {
+	emitter.RLock()
+	handler, logger, writer := emitter.warningHandler, emitter.logger, emitter.warningWriter
+	max := emitter.maxListeners
+	emitter.RUnlock()
+
+	switch {
+	case nil != handler:
+		handler(event, count)
+	case nil != logger:
+		logger.Warn("event has exceeded the maximum number of listeners",
+			"event", event, "count", count, "max", max, "listener", name)
+	default:
+		if nil == writer {
+			writer = os.Stdout
+		}
+
+		fmt.Fprintf(writer, "Warning: event `%v` has exceeded the maximum "+
+			"number of listeners of %d, adding listener `%s`.\n", event, max, name)
+	}
+}
@@ -0,0 +1,37 @@
+package emission
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestSetWarningHandler(t *testing.T) {
+	var (
+		warned interface{}
+		count  int
+	)
+
+	NewEmitter().
+		SetMaxListeners(1).
+		SetWarningHandler(func(event interface{}, n int) { warned, count = event, n }).
+		On("test", func() {}).
+		On("test", func() {})
+
+	if "test" != warned || 2 != count {
+		t.Error("Failed to call the WarningHandler.", warned, count)
+	}
+}
+
+func TestSetWarningWriter(t *testing.T) {
+	var buffer bytes.Buffer
+
+	NewEmitter().
+		SetMaxListeners(0).
+		SetWarningWriter(&buffer).
+		On("test", func() {})
+
+	if !strings.Contains(buffer.String(), "Warning: event `test` has exceeded") {
+		t.Error("Failed to write the warning to the writer.", buffer.String())
+	}
+}